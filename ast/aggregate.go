@@ -0,0 +1,378 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rows produced by grouping store the computed aggregate values
+// in an object under this key, indexed by the aggregate's Key()
+const AGGREGATES_KEY = "__aggregates"
+
+type AggregateFunction interface {
+	Expression
+	Key() string
+	// the expression being aggregated, nil for COUNT(*)
+	Operand() Expression
+	NewAccumulator() Accumulator
+}
+
+// an Accumulator computes the value of one aggregate function
+// for one group, it is updated once for each row in the group
+type Accumulator interface {
+	Accumulate(context Context) error
+	// add count rows for which the operand evaluated to value
+	// used when rows have already been combined (by a view reduce)
+	AccumulateValue(value interface{}, count int)
+	Value() interface{}
+}
+
+func NewAggregateFunction(name string, operand Expression) (AggregateFunction, error) {
+	switch strings.ToUpper(name) {
+	case "COUNT":
+		return NewCountAggregate(operand), nil
+	case "SUM":
+		if operand != nil {
+			return NewSumAggregate(operand), nil
+		}
+	case "AVG":
+		if operand != nil {
+			return NewAvgAggregate(operand), nil
+		}
+	case "MIN":
+		if operand != nil {
+			return NewMinAggregate(operand), nil
+		}
+	case "MAX":
+		if operand != nil {
+			return NewMaxAggregate(operand), nil
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported aggregate function %v", name)
+	}
+	return nil, fmt.Errorf("Aggregate function %v requires an argument", strings.ToUpper(name))
+}
+
+// look up the value computed for this aggregate in the group row
+func evaluateAggregate(this AggregateFunction, context Context) (interface{}, error) {
+	aggregates, err := context.GetPath(AGGREGATES_KEY)
+	if err != nil {
+		return nil, err
+	}
+	switch aggregates := aggregates.(type) {
+	case map[string]interface{}:
+		value, ok := aggregates[this.Key()]
+		if ok {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("Aggregate %v has not been computed, aggregates can only be used in aggregate queries", this.Key())
+}
+
+type CountAggregate struct {
+	operand Expression
+}
+
+// a nil operand means COUNT(*)
+func NewCountAggregate(operand Expression) *CountAggregate {
+	return &CountAggregate{
+		operand: operand,
+	}
+}
+
+func (this *CountAggregate) Evaluate(context Context) (interface{}, error) {
+	return evaluateAggregate(this, context)
+}
+
+func (this *CountAggregate) Key() string {
+	return this.String()
+}
+
+func (this *CountAggregate) Operand() Expression {
+	return this.operand
+}
+
+func (this *CountAggregate) NewAccumulator() Accumulator {
+	return &countAccumulator{operand: this.operand}
+}
+
+func (this *CountAggregate) String() string {
+	if this.operand == nil {
+		return "COUNT(*)"
+	}
+	return fmt.Sprintf("COUNT(%v)", this.operand)
+}
+
+func (this *CountAggregate) ReferencedProperties() []Property {
+	if this.operand == nil {
+		return []Property{}
+	}
+	return this.operand.ReferencedProperties()
+}
+
+func (this *CountAggregate) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{this}
+}
+
+type countAccumulator struct {
+	operand Expression
+	count   int
+}
+
+func (this *countAccumulator) Accumulate(context Context) error {
+	if this.operand == nil {
+		this.AccumulateValue(nil, 1)
+		return nil
+	}
+	value, err := this.operand.Evaluate(context)
+	if err != nil {
+		return err
+	}
+	this.AccumulateValue(value, 1)
+	return nil
+}
+
+func (this *countAccumulator) AccumulateValue(value interface{}, count int) {
+	// NULL and MISSING values are eliminated
+	if this.operand == nil || (value != nil && value != MISSING) {
+		this.count += count
+	}
+}
+
+func (this *countAccumulator) Value() interface{} {
+	return float64(this.count)
+}
+
+type SumAggregate struct {
+	operand Expression
+}
+
+func NewSumAggregate(operand Expression) *SumAggregate {
+	return &SumAggregate{
+		operand: operand,
+	}
+}
+
+func (this *SumAggregate) Evaluate(context Context) (interface{}, error) {
+	return evaluateAggregate(this, context)
+}
+
+func (this *SumAggregate) Key() string {
+	return this.String()
+}
+
+func (this *SumAggregate) Operand() Expression {
+	return this.operand
+}
+
+func (this *SumAggregate) NewAccumulator() Accumulator {
+	return &sumAccumulator{operand: this.operand}
+}
+
+func (this *SumAggregate) String() string {
+	return fmt.Sprintf("SUM(%v)", this.operand)
+}
+
+func (this *SumAggregate) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *SumAggregate) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{this}
+}
+
+type sumAccumulator struct {
+	operand Expression
+	sum     float64
+	count   int
+}
+
+func (this *sumAccumulator) Accumulate(context Context) error {
+	value, err := this.operand.Evaluate(context)
+	if err != nil {
+		return err
+	}
+	this.AccumulateValue(value, 1)
+	return nil
+}
+
+func (this *sumAccumulator) AccumulateValue(value interface{}, count int) {
+	// non-numeric values are eliminated
+	switch value := value.(type) {
+	case float64:
+		this.sum += value * float64(count)
+		this.count += count
+	}
+}
+
+func (this *sumAccumulator) Value() interface{} {
+	if this.count == 0 {
+		return nil
+	}
+	return this.sum
+}
+
+type AvgAggregate struct {
+	operand Expression
+}
+
+func NewAvgAggregate(operand Expression) *AvgAggregate {
+	return &AvgAggregate{
+		operand: operand,
+	}
+}
+
+func (this *AvgAggregate) Evaluate(context Context) (interface{}, error) {
+	return evaluateAggregate(this, context)
+}
+
+func (this *AvgAggregate) Key() string {
+	return this.String()
+}
+
+func (this *AvgAggregate) Operand() Expression {
+	return this.operand
+}
+
+func (this *AvgAggregate) NewAccumulator() Accumulator {
+	return &avgAccumulator{sumAccumulator{operand: this.operand}}
+}
+
+func (this *AvgAggregate) String() string {
+	return fmt.Sprintf("AVG(%v)", this.operand)
+}
+
+func (this *AvgAggregate) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *AvgAggregate) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{this}
+}
+
+type avgAccumulator struct {
+	sumAccumulator
+}
+
+func (this *avgAccumulator) Value() interface{} {
+	if this.count == 0 {
+		return nil
+	}
+	return this.sum / float64(this.count)
+}
+
+type MinAggregate struct {
+	operand Expression
+}
+
+func NewMinAggregate(operand Expression) *MinAggregate {
+	return &MinAggregate{
+		operand: operand,
+	}
+}
+
+func (this *MinAggregate) Evaluate(context Context) (interface{}, error) {
+	return evaluateAggregate(this, context)
+}
+
+func (this *MinAggregate) Key() string {
+	return this.String()
+}
+
+func (this *MinAggregate) Operand() Expression {
+	return this.operand
+}
+
+func (this *MinAggregate) NewAccumulator() Accumulator {
+	return &collateAccumulator{operand: this.operand, keep: -1}
+}
+
+func (this *MinAggregate) String() string {
+	return fmt.Sprintf("MIN(%v)", this.operand)
+}
+
+func (this *MinAggregate) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *MinAggregate) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{this}
+}
+
+type MaxAggregate struct {
+	operand Expression
+}
+
+func NewMaxAggregate(operand Expression) *MaxAggregate {
+	return &MaxAggregate{
+		operand: operand,
+	}
+}
+
+func (this *MaxAggregate) Evaluate(context Context) (interface{}, error) {
+	return evaluateAggregate(this, context)
+}
+
+func (this *MaxAggregate) Key() string {
+	return this.String()
+}
+
+func (this *MaxAggregate) Operand() Expression {
+	return this.operand
+}
+
+func (this *MaxAggregate) NewAccumulator() Accumulator {
+	return &collateAccumulator{operand: this.operand, keep: 1}
+}
+
+func (this *MaxAggregate) String() string {
+	return fmt.Sprintf("MAX(%v)", this.operand)
+}
+
+func (this *MaxAggregate) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *MaxAggregate) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{this}
+}
+
+// collateAccumulator implements both MIN and MAX, using the same
+// collation as ORDER BY.  keep is -1 to keep the smallest value
+// and 1 to keep the largest value
+type collateAccumulator struct {
+	operand Expression
+	keep    int
+	value   interface{}
+}
+
+func (this *collateAccumulator) Accumulate(context Context) error {
+	value, err := this.operand.Evaluate(context)
+	if err != nil {
+		return err
+	}
+	this.AccumulateValue(value, 1)
+	return nil
+}
+
+func (this *collateAccumulator) AccumulateValue(value interface{}, count int) {
+	if value == nil || value == MISSING {
+		return
+	}
+	if this.value == nil || CollateJSON(value, this.value)*this.keep > 0 {
+		this.value = value
+	}
+}
+
+func (this *collateAccumulator) Value() interface{} {
+	return this.value
+}
@@ -0,0 +1,155 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregates(t *testing.T) {
+
+	rows := []map[string]interface{}{
+		{"name": "mark", "age": 32.0},
+		{"name": "steve", "age": 27.0},
+		{"name": "marty", "age": "unknown"},
+		{"name": "gerald"},
+		{"name": nil, "age": 45.0},
+	}
+
+	name := NewProperty("name")
+	age := NewProperty("age")
+
+	tests := []struct {
+		input  AggregateFunction
+		output interface{}
+	}{
+		{NewCountAggregate(nil), 5.0},
+		{NewCountAggregate(name), 4.0},
+		{NewCountAggregate(age), 4.0},
+		{NewSumAggregate(age), 104.0},
+		{NewSumAggregate(name), nil},
+		{NewAvgAggregate(age), 104.0 / 3.0},
+		{NewAvgAggregate(name), nil},
+		{NewMinAggregate(age), 27.0},
+		{NewMinAggregate(name), "gerald"},
+		{NewMaxAggregate(age), "unknown"},
+		{NewMaxAggregate(name), "steve"},
+		{NewMaxAggregate(NewProperty("nothere")), nil},
+	}
+
+	for _, x := range tests {
+		accumulator := x.input.NewAccumulator()
+		for _, row := range rows {
+			err := accumulator.Accumulate(NewContext(row))
+			if err != nil {
+				t.Fatalf("Error accumulating %v: %v", x.input, err)
+			}
+		}
+		result := accumulator.Value()
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+
+		// the computed value is available to rows produced by grouping
+		groupRow := map[string]interface{}{AGGREGATES_KEY: map[string]interface{}{x.input.Key(): result}}
+		result, err := x.input.Evaluate(NewContext(groupRow))
+		if err != nil {
+			t.Fatalf("Error evaluating %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestAccumulateValue(t *testing.T) {
+
+	// values as they would be returned by a view reduce
+	values := []struct {
+		value interface{}
+		count int
+	}{
+		{nil, 2},
+		{3.0, 4},
+		{5.0, 1},
+		{"beer", 3},
+	}
+
+	age := NewProperty("age")
+
+	tests := []struct {
+		input  AggregateFunction
+		output interface{}
+	}{
+		{NewCountAggregate(nil), 10.0},
+		{NewCountAggregate(age), 8.0},
+		{NewSumAggregate(age), 17.0},
+		{NewAvgAggregate(age), 17.0 / 5.0},
+		{NewMinAggregate(age), 3.0},
+		{NewMaxAggregate(age), "beer"},
+	}
+
+	for _, x := range tests {
+		accumulator := x.input.NewAccumulator()
+		for _, v := range values {
+			accumulator.AccumulateValue(v.value, v.count)
+		}
+		result := accumulator.Value()
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestAggregateOutsideGroup(t *testing.T) {
+	_, err := NewCountAggregate(nil).Evaluate(NewContext(map[string]interface{}{"name": "mark"}))
+	if err == nil {
+		t.Errorf("Expected error evaluating aggregate outside of a group")
+	}
+}
+
+func TestNewAggregateFunction(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		operand Expression
+		output  AggregateFunction
+		isError bool
+	}{
+		{"count", nil, NewCountAggregate(nil), false},
+		{"COUNT", NewProperty("name"), NewCountAggregate(NewProperty("name")), false},
+		{"Sum", NewProperty("age"), NewSumAggregate(NewProperty("age")), false},
+		{"avg", NewProperty("age"), NewAvgAggregate(NewProperty("age")), false},
+		{"min", NewProperty("age"), NewMinAggregate(NewProperty("age")), false},
+		{"max", NewProperty("age"), NewMaxAggregate(NewProperty("age")), false},
+		{"sum", nil, nil, true},
+		{"median", NewProperty("age"), nil, true},
+	}
+
+	for _, x := range tests {
+		result, err := NewAggregateFunction(x.name, x.operand)
+		if x.isError {
+			if err == nil {
+				t.Errorf("Expected error for %v, got %v", x.name, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error creating aggregate %v: %v", x.name, err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v, got %v", x.output, result)
+		}
+	}
+
+}
@@ -9,6 +9,10 @@
 
 package ast
 
+import (
+	"math"
+)
+
 type PlusOperator struct {
 	left  Expression
 	right Expression
@@ -31,6 +35,10 @@ func (this *PlusOperator) Evaluate(context Context) (interface{}, error) {
 		return nil, err
 	}
 
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
 	switch lv := lv.(type) {
 	case string:
 		switch rv := rv.(type) {
@@ -70,6 +78,21 @@ func (this *PlusOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *PlusOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
 type SubtractOperator struct {
 	left  Expression
 	right Expression
@@ -92,6 +115,10 @@ func (this *SubtractOperator) Evaluate(context Context) (interface{}, error) {
 		return nil, err
 	}
 
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
 	switch lv := lv.(type) {
 	case float64:
 		switch rv := rv.(type) {
@@ -123,6 +150,21 @@ func (this *SubtractOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *SubtractOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
 type MultiplyOperator struct {
 	left  Expression
 	right Expression
@@ -145,6 +187,10 @@ func (this *MultiplyOperator) Evaluate(context Context) (interface{}, error) {
 		return nil, err
 	}
 
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
 	switch lv := lv.(type) {
 	case float64:
 		switch rv := rv.(type) {
@@ -176,6 +222,21 @@ func (this *MultiplyOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *MultiplyOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
 type DivideOperator struct {
 	left  Expression
 	right Expression
@@ -198,6 +259,10 @@ func (this *DivideOperator) Evaluate(context Context) (interface{}, error) {
 		return nil, err
 	}
 
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
 	switch lv := lv.(type) {
 	case float64:
 		switch rv := rv.(type) {
@@ -228,3 +293,205 @@ func (this *DivideOperator) ReferencedProperties() []Property {
 
 	return rv
 }
+
+func (this *DivideOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
+type ModuloOperator struct {
+	left  Expression
+	right Expression
+}
+
+func NewModuloOperator(left, right Expression) *ModuloOperator {
+	return &ModuloOperator{
+		left:  left,
+		right: right,
+	}
+}
+
+func (this *ModuloOperator) Evaluate(context Context) (interface{}, error) {
+	lv, err := this.left.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := this.right.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
+	switch lv := lv.(type) {
+	case float64:
+		switch rv := rv.(type) {
+		case float64:
+			// if both values are numeric take the remainder
+			// the remainder of division by zero is not a number
+			if rv == 0 {
+				return nil, nil
+			}
+			return math.Mod(lv, rv), nil
+		default:
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	return nil, nil
+}
+
+func (this *ModuloOperator) ReferencedProperties() []Property {
+	rv := make([]Property, 0, 0)
+
+	rp := this.left.ReferencedProperties()
+	for _, rpv := range rp {
+		rv = append(rv, rpv)
+	}
+	rp = this.right.ReferencedProperties()
+	for _, rpv := range rp {
+		rv = append(rv, rpv)
+	}
+
+	return rv
+}
+
+func (this *ModuloOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
+type NegateOperator struct {
+	operand Expression
+}
+
+func NewNegateOperator(operand Expression) *NegateOperator {
+	return &NegateOperator{
+		operand: operand,
+	}
+}
+
+func (this *NegateOperator) Evaluate(context Context) (interface{}, error) {
+	ov, err := this.operand.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if ov == MISSING {
+		return MISSING, nil
+	}
+
+	switch ov := ov.(type) {
+	case float64:
+		// if the value is numeric change its sign
+		return -ov, nil
+	default:
+		return nil, nil
+	}
+
+	return nil, nil
+}
+
+func (this *NegateOperator) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *NegateOperator) ReferencedAggregates() []AggregateFunction {
+	return this.operand.ReferencedAggregates()
+}
+
+type ConcatOperator struct {
+	left  Expression
+	right Expression
+}
+
+func NewConcatOperator(left, right Expression) *ConcatOperator {
+	return &ConcatOperator{
+		left:  left,
+		right: right,
+	}
+}
+
+func (this *ConcatOperator) Evaluate(context Context) (interface{}, error) {
+	lv, err := this.left.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := this.right.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+
+	switch lv := lv.(type) {
+	case string:
+		switch rv := rv.(type) {
+		case string:
+			// if both values are strings append
+			return lv + rv, nil
+		default:
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	return nil, nil
+}
+
+func (this *ConcatOperator) ReferencedProperties() []Property {
+	rv := make([]Property, 0, 0)
+
+	rp := this.left.ReferencedProperties()
+	for _, rpv := range rp {
+		rv = append(rv, rpv)
+	}
+	rp = this.right.ReferencedProperties()
+	for _, rpv := range rp {
+		rv = append(rv, rpv)
+	}
+
+	return rv
+}
+
+func (this *ConcatOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
@@ -33,10 +33,31 @@ func TestArithmetic(t *testing.T) {
 		{NewMultiplyOperator(numberSeven, stringCouchbase), nil},
 		{NewDivideOperator(numberSeven, numberSeven), 1.0},
 		{NewDivideOperator(numberSeven, stringCouchbase), nil},
+		{NewPlusOperator(numberSeven, NewProperty("dne")), MISSING},
+		{NewSubtractOperator(NewProperty("dne"), NewLiteralNull()), MISSING},
+		{NewMultiplyOperator(stringCouchbase, NewProperty("dne")), MISSING},
+		{NewDivideOperator(NewLiteralNull(), numberSeven), nil},
+		{NewModuloOperator(numberSeven, NewLiteralNumber(3.0)), 1.0},
+		{NewModuloOperator(NewLiteralNumber(-7.0), NewLiteralNumber(3.0)), -1.0},
+		{NewModuloOperator(NewLiteralNumber(7.5), NewLiteralNumber(2.0)), 1.5},
+		{NewModuloOperator(numberSeven, NewLiteralNumber(0.0)), nil},
+		{NewModuloOperator(numberSeven, stringCouchbase), nil},
+		{NewModuloOperator(NewLiteralNull(), numberSeven), nil},
+		{NewModuloOperator(numberSeven, NewProperty("dne")), MISSING},
+		{NewConcatOperator(stringCouchbase, stringServer), "CouchbaseServer"},
+		{NewConcatOperator(stringCouchbase, numberSeven), nil},
+		{NewConcatOperator(numberSeven, numberSeven), nil},
+		{NewConcatOperator(NewLiteralNull(), stringServer), nil},
+		{NewConcatOperator(stringCouchbase, NewProperty("dne")), MISSING},
+		{NewNegateOperator(numberSeven), -7.0},
+		{NewNegateOperator(NewNegateOperator(numberSeven)), 7.0},
+		{NewNegateOperator(stringCouchbase), nil},
+		{NewNegateOperator(NewLiteralNull()), nil},
+		{NewNegateOperator(NewProperty("dne")), MISSING},
 	}
 
 	for _, x := range tests {
-		result, err := x.input.Evaluate(nil)
+		result, err := x.input.Evaluate(NewContext(map[string]interface{}{}))
 		if err != nil {
 			t.Fatalf("Error evaluating expression: %v", err)
 		}
@@ -0,0 +1,137 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// operand BETWEEN low AND high is the same as
+// operand >= low AND operand <= high
+type BetweenOperator struct {
+	operand Expression
+	low     Expression
+	high    Expression
+}
+
+func NewBetweenOperator(operand, low, high Expression) *BetweenOperator {
+	return &BetweenOperator{
+		operand: operand,
+		low:     low,
+		high:    high,
+	}
+}
+
+func (this *BetweenOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+func (this *BetweenOperator) Evaluate(context Context) (interface{}, error) {
+	conjunction := NewAndOperator([]BooleanExpression{
+		NewGreaterThanOrEqualOperator(this.operand, this.low),
+		NewLessThanOrEqualOperator(this.operand, this.high),
+	})
+	return conjunction.Evaluate(context)
+}
+
+func (this *BetweenOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *BetweenOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *BetweenOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *BetweenOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *BetweenOperator) String() string {
+	return fmt.Sprintf("%v BETWEEN %v AND %v", this.operand, this.low, this.high)
+}
+
+func (this *BetweenOperator) ReferencedProperties() []Property {
+	rv := this.operand.ReferencedProperties()
+	rv = append(rv, this.low.ReferencedProperties()...)
+	return append(rv, this.high.ReferencedProperties()...)
+}
+
+func (this *BetweenOperator) ReferencedAggregates() []AggregateFunction {
+	rv := this.operand.ReferencedAggregates()
+	rv = append(rv, this.low.ReferencedAggregates()...)
+	return append(rv, this.high.ReferencedAggregates()...)
+}
+
+// a property between two literals is sargable
+func (this *BetweenOperator) IsSargable() bool {
+	switch this.operand.(type) {
+	case *Property:
+		return isSargableLiteral(this.low) && isSargableLiteral(this.high)
+	}
+	return false
+}
+
+func isSargableLiteral(expression Expression) bool {
+	switch expression.(type) {
+	case *LiteralBool, *LiteralNumber, *LiteralString:
+		return true
+	}
+	return false
+}
+
+func (this *BetweenOperator) GetSargProperty() *Property {
+	switch operand := this.operand.(type) {
+	case *Property:
+		return operand
+	}
+	return nil
+}
+
+// the sarg value is the array of the low and high values
+func (this *BetweenOperator) GetSargValue() (interface{}, error) {
+	low, err := this.low.Evaluate(nil)
+	if err != nil {
+		return nil, err
+	}
+	high, err := this.high.Evaluate(nil)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{low, high}, nil
+}
+
+func (this *BetweenOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	// each bound is as selective as a comparison
+	rv := 1.0 / 9.0
+
+	// logic to improve this with pathStats
+	if this.IsSargable() {
+		path := this.GetSargProperty().Path
+		pathStat, ok := pathStats[path]
+		if ok {
+			sargval, err := this.GetSargValue()
+			if err == nil {
+				bounds := sargval.([]interface{})
+				rowsEstimate := RowsBetweenValues(pathStat, bounds[0], bounds[1])
+				if rowsEstimate != -1 {
+					return float64(rowsEstimate / float64(pathStat.Rows))
+				}
+			}
+		}
+	}
+
+	return rv
+}
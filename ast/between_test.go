@@ -0,0 +1,132 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+func TestBetween(t *testing.T) {
+
+	row := map[string]interface{}{
+		"abv":  5.0,
+		"name": "ale",
+		"null": nil,
+	}
+
+	one := NewLiteralNumber(1.0)
+	five := NewLiteralNumber(5.0)
+	ten := NewLiteralNumber(10.0)
+
+	tests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{NewBetweenOperator(NewProperty("abv"), one, ten), true},
+		{NewBetweenOperator(NewProperty("abv"), five, five), true},
+		{NewBetweenOperator(NewProperty("abv"), one, NewLiteralNumber(4.9)), false},
+		{NewBetweenOperator(NewProperty("abv"), ten, one), false},
+		{NewBetweenOperator(NewProperty("name"), NewLiteralString("a"), NewLiteralString("b")), true},
+		{NewBetweenOperator(NewProperty("name"), one, ten), false},
+		{NewBetweenOperator(NewProperty("abv"), one, NewProperty("null")), nil},
+		{NewBetweenOperator(NewProperty("abv"), ten, NewProperty("null")), false},
+		{NewBetweenOperator(NewProperty("null"), one, ten), nil},
+		{NewBetweenOperator(NewProperty("missing"), one, ten), MISSING},
+		{NewBetweenOperator(NewProperty("abv"), NewProperty("missing"), ten), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestBetweenSargable(t *testing.T) {
+
+	tests := []struct {
+		input    *BetweenOperator
+		sargable bool
+		sargval  interface{}
+	}{
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(1.0), NewLiteralNumber(10.0)), true, []interface{}{1.0, 10.0}},
+		{NewBetweenOperator(NewProperty("doc.name"), NewLiteralString("a"), NewLiteralString("b")), true, []interface{}{"a", "b"}},
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(1.0), NewProperty("doc.ibu")), false, nil},
+		{NewBetweenOperator(NewLiteralNumber(5.0), NewProperty("doc.low"), NewProperty("doc.high")), false, nil},
+	}
+
+	for _, x := range tests {
+		if x.input.IsSargable() != x.sargable {
+			t.Errorf("Expected sargable %v for %v", x.sargable, x.input)
+		}
+		if x.sargable {
+			sargval, err := x.input.GetSargValue()
+			if err != nil {
+				t.Fatalf("Error getting sarg value: %v", err)
+			}
+			if !reflect.DeepEqual(sargval, x.sargval) {
+				t.Errorf("Expected sarg value %v for %v, got %v", x.sargval, x.input, sargval)
+			}
+		}
+	}
+
+}
+
+func TestBetweenSelectivity(t *testing.T) {
+
+	abvStats := stats.DefaultPathStats(0, 100)
+	abvStats.Rows = 50
+	abvStats.Quantiles = []stats.QuantileRange{
+		stats.QuantileRange{Start: 0.0, End: 20.0, Count: 10},
+		stats.QuantileRange{Start: 21.0, End: 40.0, Count: 10},
+		stats.QuantileRange{Start: 41.0, End: 60.0, Count: 10},
+		stats.QuantileRange{Start: 61.0, End: 80.0, Count: 10},
+		stats.QuantileRange{Start: 81.0, End: 100.0, Count: 10},
+	}
+
+	ibuStats := stats.DefaultPathStats(0, 100)
+	ibuStats.Rows = 50
+
+	pathStatistics := map[string]stats.PathStatistics{
+		"doc.abv": abvStats,
+		"doc.ibu": ibuStats,
+	}
+
+	tests := []struct {
+		input  BooleanExpression
+		output float64
+	}{
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(45.0), NewLiteralNumber(55.0)), 10.0 / 50.0},
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(30.0), NewLiteralNumber(70.0)), 30.0 / 50.0},
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(40.0), NewLiteralNumber(41.0)), 20.0 / 50.0},
+		{NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(70.0), NewLiteralNumber(30.0)), 0.0},
+		// without quantiles fall back to defaults
+		{NewBetweenOperator(NewProperty("doc.ibu"), NewLiteralNumber(30.0), NewLiteralNumber(70.0)), 1.0 / 9.0},
+		{NewBetweenOperator(NewProperty("doc.og"), NewLiteralNumber(30.0), NewLiteralNumber(70.0)), 1.0 / 9.0},
+	}
+
+	for _, x := range tests {
+		result := x.input.GetSelectivity(pathStatistics)
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
@@ -19,6 +19,7 @@ type BooleanExpression interface {
 	Evaluate(context Context) (interface{}, error)
 	EvaluateBoolean(context Context) (bool, error)
 	ReferencedProperties() []Property
+	ReferencedAggregates() []AggregateFunction
 	ConjunctiveNormalForm() BooleanExpression
 	NegationNormalForm() BooleanExpression
 	DistributeNot() BooleanExpression
@@ -34,6 +35,10 @@ type BooleanOperator struct {
 	operands []BooleanExpression
 }
 
+func (this *BooleanOperator) Operands() []BooleanExpression {
+	return this.operands
+}
+
 type AndOperator struct {
 	BooleanOperator
 }
@@ -47,19 +52,30 @@ func NewAndOperator(operands []BooleanExpression) *AndOperator {
 }
 
 func (this *AndOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// FALSE if any operand is FALSE, otherwise MISSING if any
+// operand is MISSING, otherwise NULL if any operand is NULL
+func (this *AndOperator) Evaluate(context Context) (interface{}, error) {
+	var rv interface{} = true
 	for _, operand := range this.operands {
-		operandVal, err := operand.EvaluateBoolean(context)
+		operandVal, err := operand.Evaluate(context)
 		if err != nil {
-			return false, err
-		} else if !operandVal {
+			return nil, err
+		}
+		switch operandVal := truthValue(operandVal); operandVal {
+		case false:
 			return false, nil
+		case MISSING:
+			rv = MISSING
+		case nil:
+			if rv != MISSING {
+				rv = nil
+			}
 		}
 	}
-	return true, nil
-}
-
-func (this *AndOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return rv, nil
 }
 
 func (this *AndOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -130,6 +146,17 @@ func (this *AndOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *AndOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+	for _, v := range this.operands {
+		ra := v.ReferencedAggregates()
+		for _, rav := range ra {
+			rv = append(rv, rav)
+		}
+	}
+	return rv
+}
+
 func (this *AndOperator) IsSargable() bool {
 	return false
 }
@@ -165,19 +192,30 @@ func NewOrOperator(operands []BooleanExpression) *OrOperator {
 }
 
 func (this *OrOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// TRUE if any operand is TRUE, otherwise MISSING if any
+// operand is MISSING, otherwise NULL if any operand is NULL
+func (this *OrOperator) Evaluate(context Context) (interface{}, error) {
+	var rv interface{} = false
 	for _, operand := range this.operands {
-		operandVal, err := operand.EvaluateBoolean(context)
+		operandVal, err := operand.Evaluate(context)
 		if err != nil {
-			return false, err
-		} else if operandVal {
+			return nil, err
+		}
+		switch operandVal := truthValue(operandVal); operandVal {
+		case true:
 			return true, nil
+		case MISSING:
+			rv = MISSING
+		case nil:
+			if rv != MISSING {
+				rv = nil
+			}
 		}
 	}
-	return false, nil
-}
-
-func (this *OrOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return rv, nil
 }
 
 func (this *OrOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -276,16 +314,57 @@ func (this *OrOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *OrOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+	for _, v := range this.operands {
+		ra := v.ReferencedAggregates()
+		for _, rav := range ra {
+			rv = append(rv, rav)
+		}
+	}
+	return rv
+}
+
+// sargable when every operand is sargable on the same property
 func (this *OrOperator) IsSargable() bool {
-	return false
+	if len(this.operands) == 0 {
+		return false
+	}
+	path := ""
+	for i, operand := range this.operands {
+		if !operand.IsSargable() {
+			return false
+		}
+		if i == 0 {
+			path = operand.GetSargProperty().Path
+		} else if operand.GetSargProperty().Path != path {
+			return false
+		}
+	}
+	return true
 }
 
 func (this *OrOperator) GetSargProperty() *Property {
+	if this.IsSargable() {
+		return this.operands[0].GetSargProperty()
+	}
 	return nil
 }
 
+// the sarg value is the array of the sarg values of the operands
 func (this *OrOperator) GetSargValue() (interface{}, error) {
-	return nil, nil
+	if !this.IsSargable() {
+		return nil, nil
+	}
+	rv := make([]interface{}, len(this.operands))
+	for i, operand := range this.operands {
+		sargval, err := operand.GetSargValue()
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = sargval
+	}
+	return rv, nil
 }
 
 func (this *OrOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
@@ -310,16 +389,21 @@ func NewNotOperator(operand BooleanExpression) *NotOperator {
 }
 
 func (this *NotOperator) EvaluateBoolean(context Context) (bool, error) {
-	ov, err := this.operand.EvaluateBoolean(context)
-	if err != nil {
-		return false, err
-	}
-
-	return !ov, nil
+	return evaluateBoolean(this, context)
 }
 
+// NULL and MISSING are unchanged by NOT
 func (this *NotOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	ov, err := this.operand.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	switch ov := truthValue(ov).(type) {
+	case bool:
+		return !ov, nil
+	default:
+		return ov, nil
+	}
 }
 
 func (this *NotOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -350,6 +434,10 @@ func (this *NotOperator) ReferencedProperties() []Property {
 	return this.operand.ReferencedProperties()
 }
 
+func (this *NotOperator) ReferencedAggregates() []AggregateFunction {
+	return this.operand.ReferencedAggregates()
+}
+
 func (this *NotOperator) IsSargable() bool {
 	return false
 }
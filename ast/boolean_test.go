@@ -58,6 +58,91 @@ func TestBoolean(t *testing.T) {
 
 }
 
+func TestFourValuedLogic(t *testing.T) {
+
+	// comparisons involving NULL evaluate to NULL
+	// and those involving MISSING evaluate to MISSING
+	booleanTrue := NewLiteralBool(true)
+	booleanFalse := NewLiteralBool(false)
+	booleanNull := NewEqualToOperator(NewLiteralNull(), NewLiteralNumber(1.0))
+	booleanMissing := NewEqualToOperator(NewProperty("dne"), NewLiteralNumber(1.0))
+
+	tests := []struct {
+		left      BooleanExpression
+		right     BooleanExpression
+		andOutput interface{}
+		orOutput  interface{}
+	}{
+		{booleanFalse, booleanFalse, false, false},
+		{booleanFalse, booleanNull, false, nil},
+		{booleanFalse, booleanMissing, false, MISSING},
+		{booleanFalse, booleanTrue, false, true},
+		{booleanNull, booleanFalse, false, nil},
+		{booleanNull, booleanNull, nil, nil},
+		{booleanNull, booleanMissing, MISSING, MISSING},
+		{booleanNull, booleanTrue, nil, true},
+		{booleanMissing, booleanFalse, false, MISSING},
+		{booleanMissing, booleanNull, MISSING, MISSING},
+		{booleanMissing, booleanMissing, MISSING, MISSING},
+		{booleanMissing, booleanTrue, MISSING, true},
+		{booleanTrue, booleanFalse, false, true},
+		{booleanTrue, booleanNull, nil, true},
+		{booleanTrue, booleanMissing, MISSING, true},
+		{booleanTrue, booleanTrue, true, true},
+	}
+
+	context := NewContext(map[string]interface{}{})
+
+	for _, x := range tests {
+		and := NewAndOperator([]BooleanExpression{x.left, x.right})
+		result, err := and.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.andOutput) {
+			t.Errorf("Expected %v for %v AND %v, got %v", x.andOutput, x.left, x.right, result)
+		}
+		// only TRUE passes a WHERE clause
+		passes, err := and.EvaluateBoolean(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if passes != (x.andOutput == true) {
+			t.Errorf("Expected %v AND %v to pass %v, got %v", x.left, x.right, x.andOutput == true, passes)
+		}
+
+		or := NewOrOperator([]BooleanExpression{x.left, x.right})
+		result, err = or.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.orOutput) {
+			t.Errorf("Expected %v for %v OR %v, got %v", x.orOutput, x.left, x.right, result)
+		}
+	}
+
+	notTests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{booleanFalse, true},
+		{booleanNull, nil},
+		{booleanMissing, MISSING},
+		{booleanTrue, false},
+	}
+
+	for _, x := range notTests {
+		result, err := NewNotOperator(x.input).Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for NOT %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
 func TestNNF(t *testing.T) {
 
 	booleanTrue := NewLiteralBool(true)
@@ -241,3 +326,44 @@ func TestBooleanSelectivityNoStats(t *testing.T) {
 		}
 	}
 }
+
+func TestOrSargable(t *testing.T) {
+
+	red := NewEqualToOperator(NewProperty("doc.color"), NewLiteralString("red"))
+	blue := NewEqualToOperator(NewLiteralString("blue"), NewProperty("doc.color"))
+	dark := NewLessThanOperator(NewProperty("doc.color"), NewLiteralString("c"))
+	strong := NewGreaterThanOperator(NewProperty("doc.abv"), NewLiteralNumber(7.0))
+
+	tests := []struct {
+		input    *OrOperator
+		sargable bool
+		sargval  interface{}
+	}{
+		{NewOrOperator([]BooleanExpression{red, blue}), true, []interface{}{"red", "blue"}},
+		{NewOrOperator([]BooleanExpression{red, dark}), true, []interface{}{"red", "c"}},
+		{NewOrOperator([]BooleanExpression{red, strong}), false, nil},
+		{NewOrOperator([]BooleanExpression{red, NewIsMissingOperator(NewProperty("doc.color"))}), false, nil},
+		{NewOrOperator([]BooleanExpression{}), false, nil},
+	}
+
+	for _, x := range tests {
+		if x.input.IsSargable() != x.sargable {
+			t.Errorf("Expected sargable %v for %v", x.sargable, x.input)
+		}
+		if x.sargable {
+			if x.input.GetSargProperty().Path != "doc.color" {
+				t.Errorf("Expected sarg property doc.color for %v, got %v", x.input, x.input.GetSargProperty())
+			}
+			sargval, err := x.input.GetSargValue()
+			if err != nil {
+				t.Fatalf("Error getting sarg value: %v", err)
+			}
+			if !reflect.DeepEqual(sargval, x.sargval) {
+				t.Errorf("Expected sarg value %v for %v, got %v", x.sargval, x.input, sargval)
+			}
+		} else if x.input.GetSargProperty() != nil {
+			t.Errorf("Expected no sarg property for %v", x.input)
+		}
+	}
+
+}
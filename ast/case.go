@@ -0,0 +1,166 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// one WHEN expr THEN expr clause of a CASE
+type WhenThen struct {
+	When Expression
+	Then Expression
+}
+
+func NewWhenThen(when, then Expression) *WhenThen {
+	return &WhenThen{
+		When: when,
+		Then: then,
+	}
+}
+
+func (this *WhenThen) String() string {
+	return fmt.Sprintf("WHEN %v THEN %v", this.When, this.Then)
+}
+
+// the searched form CASE WHEN condition THEN ... has no Operand and
+// takes the first clause whose condition is TRUE, the simple form
+// CASE operand WHEN value THEN ... takes the first clause whose value
+// is equal to the operand, without a matching clause the value is
+// the Else expression or NULL when there is none
+type CaseOperator struct {
+	Operand   Expression
+	WhenThens []*WhenThen
+	Else      Expression
+}
+
+func NewCaseOperator(operand Expression, whenThens []*WhenThen, elseExpression Expression) *CaseOperator {
+	return &CaseOperator{
+		Operand:   operand,
+		WhenThens: whenThens,
+		Else:      elseExpression,
+	}
+}
+
+func (this *CaseOperator) Evaluate(context Context) (interface{}, error) {
+	var operand interface{}
+	if this.Operand != nil {
+		value, err := this.Operand.Evaluate(context)
+		if err != nil {
+			return nil, err
+		}
+		operand = value
+	}
+
+	for _, whenThen := range this.WhenThens {
+		when, err := whenThen.When.Evaluate(context)
+		if err != nil {
+			return nil, err
+		}
+		matched := false
+		if this.Operand != nil {
+			matched = argumentsEqual(operand, when)
+		} else {
+			matched = truthValue(when) == true
+		}
+		if matched {
+			return whenThen.Then.Evaluate(context)
+		}
+	}
+
+	if this.Else != nil {
+		return this.Else.Evaluate(context)
+	}
+	return nil, nil
+}
+
+func (this *CaseOperator) String() string {
+	rv := "CASE"
+	if this.Operand != nil {
+		rv = fmt.Sprintf("%v %v", rv, this.Operand)
+	}
+	for _, whenThen := range this.WhenThens {
+		rv = fmt.Sprintf("%v %v", rv, whenThen)
+	}
+	if this.Else != nil {
+		rv = fmt.Sprintf("%v ELSE %v", rv, this.Else)
+	}
+	return rv + " END"
+}
+
+func (this *CaseOperator) ReferencedProperties() []Property {
+	rv := make([]Property, 0)
+	if this.Operand != nil {
+		rv = append(rv, this.Operand.ReferencedProperties()...)
+	}
+	for _, whenThen := range this.WhenThens {
+		rv = append(rv, whenThen.When.ReferencedProperties()...)
+		rv = append(rv, whenThen.Then.ReferencedProperties()...)
+	}
+	if this.Else != nil {
+		rv = append(rv, this.Else.ReferencedProperties()...)
+	}
+	return rv
+}
+
+func (this *CaseOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0)
+	if this.Operand != nil {
+		rv = append(rv, this.Operand.ReferencedAggregates()...)
+	}
+	for _, whenThen := range this.WhenThens {
+		rv = append(rv, whenThen.When.ReferencedAggregates()...)
+		rv = append(rv, whenThen.Then.ReferencedAggregates()...)
+	}
+	if this.Else != nil {
+		rv = append(rv, this.Else.ReferencedAggregates()...)
+	}
+	return rv
+}
+
+// a CASE can be used as a condition when its results are booleans
+
+func (this *CaseOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+func (this *CaseOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CaseOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CaseOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *CaseOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *CaseOperator) IsSargable() bool {
+	return false
+}
+
+func (this *CaseOperator) GetSargProperty() *Property {
+	return nil
+}
+
+func (this *CaseOperator) GetSargValue() (interface{}, error) {
+	return nil, nil
+}
+
+func (this *CaseOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	return 1.0 / 3.0
+}
@@ -0,0 +1,123 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCase(t *testing.T) {
+
+	row := map[string]interface{}{
+		"name": "Pale Ale",
+		"type": "beer",
+		"abv":  5.5,
+		"null": nil,
+	}
+
+	strength := []*WhenThen{
+		NewWhenThen(NewGreaterThanOperator(NewProperty("abv"), NewLiteralNumber(7.0)), NewLiteralString("strong")),
+		NewWhenThen(NewGreaterThanOperator(NewProperty("abv"), NewLiteralNumber(4.0)), NewLiteralString("regular")),
+	}
+	kind := []*WhenThen{
+		NewWhenThen(NewLiteralString("wine"), NewLiteralNumber(1.0)),
+		NewWhenThen(NewLiteralString("beer"), NewLiteralNumber(2.0)),
+	}
+
+	tests := []struct {
+		input  Expression
+		output interface{}
+	}{
+		{NewCaseOperator(nil, strength, NewLiteralString("light")), "regular"},
+		{NewCaseOperator(nil, strength[0:1], NewLiteralString("light")), "light"},
+		{NewCaseOperator(nil, strength[0:1], nil), nil},
+		{NewCaseOperator(nil, []*WhenThen{NewWhenThen(NewProperty("name"), NewProperty("type"))}, nil), "beer"},
+		{NewCaseOperator(nil, []*WhenThen{NewWhenThen(NewProperty("null"), NewLiteralNumber(1.0))}, NewLiteralNumber(2.0)), 2.0},
+		{NewCaseOperator(nil, []*WhenThen{NewWhenThen(NewProperty("missing"), NewLiteralNumber(1.0))}, NewLiteralNumber(2.0)), 2.0},
+		{NewCaseOperator(nil, strength, NewProperty("missing")), "regular"},
+		{NewCaseOperator(nil, strength[0:1], NewProperty("missing")), MISSING},
+
+		{NewCaseOperator(NewProperty("type"), kind, nil), 2.0},
+		{NewCaseOperator(NewProperty("name"), kind, nil), nil},
+		{NewCaseOperator(NewProperty("name"), kind, NewLiteralNumber(3.0)), 3.0},
+		{NewCaseOperator(NewProperty("abv"), []*WhenThen{NewWhenThen(NewLiteralString("5.5"), NewLiteralNumber(1.0))}, NewLiteralNumber(0.0)), 0.0},
+		{NewCaseOperator(NewProperty("null"), []*WhenThen{NewWhenThen(NewLiteralNull(), NewLiteralNumber(1.0))}, NewLiteralNumber(0.0)), 0.0},
+		{NewCaseOperator(NewProperty("missing"), []*WhenThen{NewWhenThen(NewProperty("missing"), NewLiteralNumber(1.0))}, NewLiteralNumber(0.0)), 0.0},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestCaseAsCondition(t *testing.T) {
+
+	row := map[string]interface{}{
+		"type": "beer",
+		"abv":  5.5,
+	}
+
+	tests := []struct {
+		input  BooleanExpression
+		output bool
+	}{
+		{NewCaseOperator(NewProperty("type"), []*WhenThen{
+			NewWhenThen(NewLiteralString("beer"), NewGreaterThanOperator(NewProperty("abv"), NewLiteralNumber(5.0))),
+		}, NewLiteralBool(true)), true},
+		{NewCaseOperator(NewProperty("type"), []*WhenThen{
+			NewWhenThen(NewLiteralString("beer"), NewGreaterThanOperator(NewProperty("abv"), NewLiteralNumber(6.0))),
+		}, NewLiteralBool(true)), false},
+		{NewCaseOperator(NewProperty("type"), []*WhenThen{
+			NewWhenThen(NewLiteralString("wine"), NewLiteralBool(true)),
+		}, nil), false},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.EvaluateBoolean(context)
+		if err != nil {
+			t.Fatalf("Error evaluating %v: %v", x.input, err)
+		}
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestCaseReferencedProperties(t *testing.T) {
+
+	operator := NewCaseOperator(NewProperty("doc.type"), []*WhenThen{
+		NewWhenThen(NewProperty("doc.kind"), NewProperty("doc.name")),
+	}, NewProperty("doc.abv"))
+
+	expected := []Property{*NewProperty("doc.type"), *NewProperty("doc.kind"), *NewProperty("doc.name"), *NewProperty("doc.abv")}
+	result := operator.ReferencedProperties()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	expectedString := "CASE doc.type WHEN doc.kind THEN doc.name ELSE doc.abv END"
+	if operator.String() != expectedString {
+		t.Errorf("Expected %v, got %v", expectedString, operator.String())
+	}
+
+}
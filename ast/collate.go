@@ -13,7 +13,10 @@
 package ast
 
 import (
+	"encoding/binary"
 	"fmt"
+	"sort"
+	"strconv"
 	"code.google.com/p/go.text/locale"
 	"code.google.com/p/go.exp/locale/collate"
 )
@@ -29,7 +32,7 @@ func CollateJSON(key1, key2 interface{}) int {
 		return type1 - type2
 	}
 	switch type1 {
-	case 0, 1, 2:
+	case -1, 0, 1, 2:
 		return 0
 	case 3:
 		n1 := collationToFloat64(key1)
@@ -57,16 +60,47 @@ func CollateJSON(key1, key2 interface{}) int {
 		}
 		return len(array1) - len(array2)
 	case 6:
-		return 0 // ignore ordering for catch-all stuff
+		return collateObjects(key1.(map[string]interface{}), key2.(map[string]interface{}))
 	}
 	panic("bogus collationType")
 }
 
+// objects are compared key/value by key/value, with the keys
+// examined in sorted order, larger objects sort after
+func collateObjects(object1, object2 map[string]interface{}) int {
+	keys1 := sortedKeys(object1)
+	keys2 := sortedKeys(object2)
+	for i, k1 := range keys1 {
+		if i >= len(keys2) {
+			return 1
+		}
+		if cmp := CollateJSON(k1, keys2[i]); cmp != 0 {
+			return cmp
+		}
+		if cmp := CollateJSON(object1[k1], object2[keys2[i]]); cmp != 0 {
+			return cmp
+		}
+	}
+	return len(keys1) - len(keys2)
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	rv := make([]string, 0, len(object))
+	for k := range object {
+		rv = append(rv, k)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
 func collationType(value interface{}) int {
 	if value == nil {
 		return 0
 	}
 	switch value := value.(type) {
+	case MissingValue:
+		// MISSING sorts before every other value
+		return -1
 	case bool:
 		if !value {
 			return 1
@@ -94,3 +128,53 @@ func collationToFloat64(value interface{}) float64 {
 	}
 	panic(fmt.Sprintf("collationToFloat64 doesn't understand %+v", value))
 }
+
+// CollationKey returns bytes which are equal for two values exactly
+// when CollateJSON finds the values equal, so values can be hashed
+func CollationKey(value interface{}) []byte {
+	return appendCollationKey(nil, value)
+}
+
+func appendCollationKey(key []byte, value interface{}) []byte {
+	collationType := collationType(value)
+	key = append(key, byte(collationType+1))
+	switch collationType {
+	case 3:
+		n := collationToFloat64(value)
+		if n == 0 {
+			// -0 is equal to 0
+			n = 0
+		}
+		return appendCollationBytes(key, strconv.AppendFloat(nil, n, 'g', -1, 64))
+	case 4:
+		var buffer collate.Buffer
+		return appendCollationBytes(key, icuCollator.KeyFromString(&buffer, value.(string)))
+	case 5:
+		array := value.([]interface{})
+		key = appendCollationLength(key, len(array))
+		for _, item := range array {
+			key = appendCollationKey(key, item)
+		}
+	case 6:
+		object := value.(map[string]interface{})
+		key = appendCollationLength(key, len(object))
+		for _, k := range sortedKeys(object) {
+			key = appendCollationKey(key, k)
+			key = appendCollationKey(key, object[k])
+		}
+	}
+	return key
+}
+
+// the length comes first so that the key of a value is never
+// the beginning of the key of another value
+func appendCollationBytes(key []byte, bytes []byte) []byte {
+	key = appendCollationLength(key, len(bytes))
+	return append(key, bytes...)
+}
+
+func appendCollationLength(key []byte, length int) []byte {
+	var buffer [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buffer[:], uint64(length))
+	return append(key, buffer[:n]...)
+}
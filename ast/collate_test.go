@@ -25,6 +25,9 @@ func TestCollateJSON(t *testing.T) {
 		{true, false, 1},
 		{false, true, -1},
 		{nil, float64(17), -3},
+		{MISSING, nil, -1},
+		{MISSING, MISSING, 0},
+		{float64(17), MISSING, 4},
 		{float64(1), float64(1), 0},
 		{float64(123), float64(1), 1},
 		{float64(123), 0123.0, 0},
@@ -56,6 +59,16 @@ func TestCollateJSON(t *testing.T) {
 		{[]interface{}{float64(1), []interface{}{float64(2), float64(3)}, float64(4)},
 			[]interface{}{float64(1), []interface{}{float64(2), 3.1}, float64(4), float64(5), float64(6)}, -1},
 
+		// objects
+		{map[string]interface{}{}, []interface{}{}, 1},
+		{map[string]interface{}{}, map[string]interface{}{}, 0},
+		{map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": 1.0}, 0},
+		{map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": 2.0}, -1},
+		{map[string]interface{}{"b": 1.0}, map[string]interface{}{"a": 2.0}, 1},
+		{map[string]interface{}{"a": 1.0, "b": 2.0}, map[string]interface{}{"a": 1.0}, 1},
+		{map[string]interface{}{"b": 2.0, "a": 1.0}, map[string]interface{}{"a": 1.0, "b": 2.0}, 0},
+		{map[string]interface{}{"a": map[string]interface{}{"x": true}}, map[string]interface{}{"a": map[string]interface{}{"x": false}}, 1},
+
 		// unicode strings
 		{"fréd", "fréd", 0},
 		{"ømø", "omo", 1},
@@ -71,3 +84,23 @@ func TestCollateJSON(t *testing.T) {
 	}
 
 }
+
+func TestCollationKey(t *testing.T) {
+
+	values := []interface{}{
+		MISSING, nil, false, true, 0.0, -0.0, 1.0, uint64(1), 17.5,
+		"", "a", "A", "café", "cafe\u0301", "cafe",
+		[]interface{}{}, []interface{}{"a"}, []interface{}{"a", "b"}, []interface{}{"ab"}, []interface{}{[]interface{}{"a"}, "b"},
+		map[string]interface{}{}, map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": "1"}, map[string]interface{}{"café": 1.0}, map[string]interface{}{"cafe\u0301": 1.0},
+	}
+
+	for _, left := range values {
+		for _, right := range values {
+			equal := string(CollationKey(left)) == string(CollationKey(right))
+			if equal != (CollateJSON(left, right) == 0) {
+				t.Errorf("Expected equal keys %v for %#v and %#v", !equal, left, right)
+			}
+		}
+	}
+
+}
@@ -0,0 +1,198 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// the collection operators test a condition against the elements of an
+// array, each element is bound to the identifier As while the condition
+// is evaluated and the identifier can only be used within the condition
+type CollectionOperator struct {
+	Condition BooleanExpression
+	Over      Expression
+	As        string
+}
+
+// the array being tested, when it is not an array the result of the
+// operator is returned instead: MISSING or NULL as is, otherwise FALSE
+func (this *CollectionOperator) evaluateOver(context Context) ([]interface{}, interface{}, bool, error) {
+	over, err := this.Over.Evaluate(context)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	switch over := over.(type) {
+	case []interface{}:
+		return over, nil, true, nil
+	}
+	if over == nil || over == MISSING {
+		return nil, over, false, nil
+	}
+	return nil, false, false, nil
+}
+
+func (this *CollectionOperator) evaluateCondition(context Context, element interface{}) (bool, error) {
+	return this.Condition.EvaluateBoolean(NewScopedContext(context, this.As, element))
+}
+
+// properties beginning with the identifier are not properties of the row
+func (this *CollectionOperator) ReferencedProperties() []Property {
+	rv := this.Over.ReferencedProperties()
+	for _, property := range this.Condition.ReferencedProperties() {
+		if !HasAliasPrefix(property.Path, this.As) {
+			rv = append(rv, property)
+		}
+	}
+	return rv
+}
+
+func (this *CollectionOperator) ReferencedAggregates() []AggregateFunction {
+	rv := this.Over.ReferencedAggregates()
+	rv = append(rv, this.Condition.ReferencedAggregates()...)
+	return rv
+}
+
+func (this *CollectionOperator) IsSargable() bool {
+	return false
+}
+
+func (this *CollectionOperator) GetSargProperty() *Property {
+	return nil
+}
+
+func (this *CollectionOperator) GetSargValue() (interface{}, error) {
+	return nil, nil
+}
+
+type CollectionAnyOperator struct {
+	CollectionOperator
+}
+
+func NewCollectionAnyOperator(condition BooleanExpression, over Expression, as string) *CollectionAnyOperator {
+	return &CollectionAnyOperator{
+		CollectionOperator{
+			Condition: condition,
+			Over:      over,
+			As:        as,
+		},
+	}
+}
+
+func (this *CollectionAnyOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// TRUE if the condition is TRUE for at least one element of the array
+func (this *CollectionAnyOperator) Evaluate(context Context) (interface{}, error) {
+	over, rv, ok, err := this.evaluateOver(context)
+	if err != nil || !ok {
+		return rv, err
+	}
+	for _, element := range over {
+		satisfied, err := this.evaluateCondition(context, element)
+		if err != nil {
+			return nil, err
+		}
+		if satisfied {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (this *CollectionAnyOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CollectionAnyOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CollectionAnyOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *CollectionAnyOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *CollectionAnyOperator) String() string {
+	return fmt.Sprintf("ANY %v IN %v SATISFIES %v END", this.As, this.Over, this.Condition)
+}
+
+func (this *CollectionAnyOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	// there are no statistics on the elements of arrays
+	return 1.0 / 3.0
+}
+
+type CollectionAllOperator struct {
+	CollectionOperator
+}
+
+func NewCollectionAllOperator(condition BooleanExpression, over Expression, as string) *CollectionAllOperator {
+	return &CollectionAllOperator{
+		CollectionOperator{
+			Condition: condition,
+			Over:      over,
+			As:        as,
+		},
+	}
+}
+
+func (this *CollectionAllOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// TRUE if the condition is TRUE for every element of the array
+// including when the array is empty
+func (this *CollectionAllOperator) Evaluate(context Context) (interface{}, error) {
+	over, rv, ok, err := this.evaluateOver(context)
+	if err != nil || !ok {
+		return rv, err
+	}
+	for _, element := range over {
+		satisfied, err := this.evaluateCondition(context, element)
+		if err != nil {
+			return nil, err
+		}
+		if !satisfied {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (this *CollectionAllOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CollectionAllOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *CollectionAllOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *CollectionAllOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *CollectionAllOperator) String() string {
+	return fmt.Sprintf("ALL %v IN %v SATISFIES %v END", this.As, this.Over, this.Condition)
+}
+
+func (this *CollectionAllOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	// there are no statistics on the elements of arrays
+	return 1.0 / 10.0
+}
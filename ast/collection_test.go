@@ -0,0 +1,96 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollectionOperators(t *testing.T) {
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"name":   "will",
+			"age":    40.0,
+			"empty":  []interface{}{},
+			"null":   nil,
+			"scalar": "abc",
+			"children": []interface{}{
+				map[string]interface{}{"name": "bob", "age": 5.0},
+				map[string]interface{}{"name": "jane", "age": 8.0},
+			},
+		},
+	}
+
+	children := NewProperty("doc.children")
+	olderThan := func(age float64) BooleanExpression {
+		return NewGreaterThanOperator(NewProperty("child.age"), NewLiteralNumber(age))
+	}
+
+	tests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{NewCollectionAnyOperator(olderThan(6.0), children, "child"), true},
+		{NewCollectionAnyOperator(olderThan(10.0), children, "child"), false},
+		{NewCollectionAllOperator(olderThan(4.0), children, "child"), true},
+		{NewCollectionAllOperator(olderThan(6.0), children, "child"), false},
+
+		// the condition can refer to the rest of the document
+		{NewCollectionAnyOperator(NewGreaterThanOperator(NewProperty("doc.age"), NewProperty("child.age")), children, "child"), true},
+		{NewCollectionAllOperator(NewEqualToOperator(NewProperty("child.name"), NewProperty("doc.name")), children, "child"), false},
+
+		// the identifier can be bound to scalar elements
+		{NewCollectionAnyOperator(NewEqualToOperator(NewProperty("c"), NewLiteralString("x")), NewLiteralArray([]Expression{NewLiteralString("x")}), "c"), true},
+
+		{NewCollectionAnyOperator(olderThan(6.0), NewProperty("doc.empty"), "child"), false},
+		{NewCollectionAllOperator(olderThan(6.0), NewProperty("doc.empty"), "child"), true},
+		{NewCollectionAnyOperator(olderThan(6.0), NewProperty("doc.scalar"), "child"), false},
+		{NewCollectionAllOperator(olderThan(6.0), NewProperty("doc.scalar"), "child"), false},
+		{NewCollectionAnyOperator(olderThan(6.0), NewProperty("doc.null"), "child"), nil},
+		{NewCollectionAllOperator(olderThan(6.0), NewProperty("doc.null"), "child"), nil},
+		{NewCollectionAnyOperator(olderThan(6.0), NewProperty("doc.missing"), "child"), MISSING},
+		{NewCollectionAllOperator(olderThan(6.0), NewProperty("doc.missing"), "child"), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestCollectionOperatorReferencedProperties(t *testing.T) {
+
+	condition := NewAndOperator([]BooleanExpression{
+		NewGreaterThanOperator(NewProperty("child.age"), NewLiteralNumber(6.0)),
+		NewEqualToOperator(NewProperty("doc.name"), NewProperty("child")),
+	})
+	operator := NewCollectionAnyOperator(condition, NewProperty("doc.children"), "child")
+
+	expected := []Property{*NewProperty("doc.children"), *NewProperty("doc.name")}
+	result := operator.ReferencedProperties()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if operator.IsSargable() {
+		t.Errorf("Expected %v not to be sargable", operator)
+	}
+
+}
@@ -20,17 +20,38 @@ type BinaryOperator struct {
 	right Expression
 }
 
-func (this *BinaryOperator) compare(context Context) (int, error) {
+// the result of comparing the operands with test (applied to their
+// collation), MISSING if either operand is MISSING, otherwise NULL
+// if either operand is NULL, and FALSE for operands of different types
+func (this *BinaryOperator) evaluateComparison(context Context, test func(compare int) bool) (interface{}, error) {
 	lv, err := this.left.Evaluate(context)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	rv, err := this.right.Evaluate(context)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return CollateJSON(lv, rv), nil
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+	if lv == nil || rv == nil {
+		return nil, nil
+	}
+	if !sameType(lv, rv) {
+		return false, nil
+	}
+	return test(CollateJSON(lv, rv)), nil
+}
+
+func sameType(left, right interface{}) bool {
+	switch left.(type) {
+	case bool:
+		_, ok := right.(bool)
+		return ok
+	}
+	return collationType(left) == collationType(right)
 }
 
 func (this *BinaryOperator) ReferencedProperties() []Property {
@@ -48,6 +69,21 @@ func (this *BinaryOperator) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *BinaryOperator) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+
+	ra := this.left.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+	ra = this.right.ReferencedAggregates()
+	for _, rav := range ra {
+		rv = append(rv, rav)
+	}
+
+	return rv
+}
+
 func (this *BinaryOperator) IsSargable() bool {
 	switch this.left.(type) {
 	case *Property:
@@ -106,15 +142,13 @@ func NewGreaterThanOperator(left, right Expression) *GreaterThanOperator {
 }
 
 func (this *GreaterThanOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare > 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *GreaterThanOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare > 0
+	})
 }
 
 func (this *GreaterThanOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -172,15 +206,13 @@ func NewGreaterThanOrEqualOperator(left, right Expression) *GreaterThanOrEqualOp
 }
 
 func (this *GreaterThanOrEqualOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare >= 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *GreaterThanOrEqualOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare >= 0
+	})
 }
 
 func (this *GreaterThanOrEqualOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -245,15 +277,13 @@ func NewLessThanOperator(left, right Expression) *LessThanOperator {
 }
 
 func (this *LessThanOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare < 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *LessThanOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare < 0
+	})
 }
 
 func (this *LessThanOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -311,15 +341,13 @@ func NewLessThanOrEqualOperator(left, right Expression) *LessThanOrEqualOperator
 }
 
 func (this *LessThanOrEqualOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare <= 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *LessThanOrEqualOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare <= 0
+	})
 }
 
 func (this *LessThanOrEqualOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -384,15 +412,13 @@ func NewEqualToOperator(left, right Expression) *EqualToOperator {
 }
 
 func (this *EqualToOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare == 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *EqualToOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare == 0
+	})
 }
 
 func (this *EqualToOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -450,15 +476,13 @@ func NewNotEqualToOperator(left, right Expression) *NotEqualToOperator {
 }
 
 func (this *NotEqualToOperator) EvaluateBoolean(context Context) (bool, error) {
-	compare, err := this.BinaryOperator.compare(context)
-	if err != nil {
-		return false, err
-	}
-	return compare != 0, nil
+	return evaluateBoolean(this, context)
 }
 
 func (this *NotEqualToOperator) Evaluate(context Context) (interface{}, error) {
-	return this.EvaluateBoolean(context)
+	return this.BinaryOperator.evaluateComparison(context, func(compare int) bool {
+		return compare != 0
+	})
 }
 
 func (this *NotEqualToOperator) ConjunctiveNormalForm() BooleanExpression {
@@ -539,9 +563,15 @@ func RowsBetweenValues(ps stats.PathStatistics, left interface{}, right interfac
 	if len(ps.Quantiles) <= 0 {
 		return -1
 	}
-	rv := float64(ps.Rows)
-	rv = rv - RowsLessThanValue(ps, left)
-	rv = rv - RowsGreaterThanValue(ps, right)
+
+	rv := 0.0
+	// add the rows of every quantile
+	// overlapping the values
+	for _, quantile := range ps.Quantiles {
+		if CollateJSON(quantile.End, left) >= 0 && CollateJSON(quantile.Start, right) <= 0 {
+			rv = rv + float64(quantile.Count)
+		}
+	}
 	return rv
 }
 
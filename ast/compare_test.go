@@ -62,6 +62,46 @@ func TestCompare(t *testing.T) {
 
 }
 
+func TestCompareNullAndMissing(t *testing.T) {
+
+	numberNine := NewLiteralNumber(9.0)
+	null := NewLiteralNull()
+	missing := NewProperty("dne")
+
+	tests := []struct {
+		input  Expression
+		output interface{}
+	}{
+		{NewEqualToOperator(null, numberNine), nil},
+		{NewEqualToOperator(null, null), nil},
+		{NewNotEqualToOperator(numberNine, null), nil},
+		{NewGreaterThanOperator(numberNine, null), nil},
+		{NewEqualToOperator(missing, numberNine), MISSING},
+		{NewEqualToOperator(missing, null), MISSING},
+		{NewEqualToOperator(missing, missing), MISSING},
+		{NewLessThanOrEqualOperator(null, missing), MISSING},
+
+		// operands of different types always compare FALSE
+		{NewEqualToOperator(numberNine, NewLiteralString("9")), false},
+		{NewNotEqualToOperator(numberNine, NewLiteralString("9")), false},
+		{NewLessThanOperator(numberNine, NewLiteralString("9")), false},
+		{NewLessThanOperator(NewLiteralBool(false), NewLiteralBool(true)), true},
+	}
+
+	context := NewContext(map[string]interface{}{})
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
 func TestCompareSelectivityNoStats(t *testing.T) {
 
 	numberSixty := NewLiteralNumber(60.0)
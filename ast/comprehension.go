@@ -0,0 +1,217 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+)
+
+// one OVER path AS identifier IF condition clause of a comprehension
+// the condition is optional, nil means every element is kept
+type ComprehensionOver struct {
+	Over Expression
+	As   string
+	If   BooleanExpression
+}
+
+func NewComprehensionOver(over Expression, as string, condition BooleanExpression) *ComprehensionOver {
+	return &ComprehensionOver{
+		Over: over,
+		As:   as,
+		If:   condition,
+	}
+}
+
+func (this *ComprehensionOver) String() string {
+	if this.If != nil {
+		return fmt.Sprintf("OVER %v AS %v IF %v", this.Over, this.As, this.If)
+	}
+	return fmt.Sprintf("OVER %v AS %v", this.Over, this.As)
+}
+
+// a comprehension evaluates the Output expression for the elements of
+// the arrays in its OVER clauses, chained clauses are nested loops and
+// each one can use the identifiers of the clauses before it
+type Comprehension struct {
+	Output Expression
+	Overs  []*ComprehensionOver
+}
+
+// collects up to limit values (-1 for no limit), when the outermost path
+// is not an array the result of the comprehension is returned instead:
+// MISSING as is, otherwise NULL
+func (this *Comprehension) evaluate(context Context, limit int) ([]interface{}, interface{}, bool, error) {
+	over, err := this.Overs[0].Over.Evaluate(context)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	switch over.(type) {
+	case []interface{}:
+		rv := make([]interface{}, 0)
+		rv, err = this.collect(context, 0, rv, limit)
+		return rv, nil, true, err
+	}
+	if over == MISSING {
+		return nil, MISSING, false, nil
+	}
+	return nil, nil, false, nil
+}
+
+// inner paths that are not arrays contribute no values
+func (this *Comprehension) collect(context Context, depth int, rv []interface{}, limit int) ([]interface{}, error) {
+	if depth == len(this.Overs) {
+		value, err := this.Output.Evaluate(context)
+		if err != nil {
+			return nil, err
+		}
+		if value == MISSING {
+			// arrays cannot contain MISSING
+			value = nil
+		}
+		return append(rv, value), nil
+	}
+
+	clause := this.Overs[depth]
+	over, err := clause.Over.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	switch over := over.(type) {
+	case []interface{}:
+		for _, element := range over {
+			scope := NewScopedContext(context, clause.As, element)
+			if clause.If != nil {
+				keep, err := clause.If.EvaluateBoolean(scope)
+				if err != nil {
+					return nil, err
+				}
+				if !keep {
+					continue
+				}
+			}
+			rv, err = this.collect(scope, depth+1, rv, limit)
+			if err != nil {
+				return nil, err
+			}
+			if limit != -1 && len(rv) >= limit {
+				return rv, nil
+			}
+		}
+	}
+	return rv, nil
+}
+
+// properties beginning with an identifier are not properties of the row
+func (this *Comprehension) ReferencedProperties() []Property {
+	rv := make([]Property, 0)
+	variables := make([]string, 0, len(this.Overs))
+	addProperties := func(properties []Property) {
+		for _, property := range properties {
+			if !hasAnyAliasPrefix(property.Path, variables) {
+				rv = append(rv, property)
+			}
+		}
+	}
+	for _, clause := range this.Overs {
+		addProperties(clause.Over.ReferencedProperties())
+		variables = append(variables, clause.As)
+		if clause.If != nil {
+			addProperties(clause.If.ReferencedProperties())
+		}
+	}
+	addProperties(this.Output.ReferencedProperties())
+	return rv
+}
+
+func (this *Comprehension) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0)
+	for _, clause := range this.Overs {
+		rv = append(rv, clause.Over.ReferencedAggregates()...)
+		if clause.If != nil {
+			rv = append(rv, clause.If.ReferencedAggregates()...)
+		}
+	}
+	rv = append(rv, this.Output.ReferencedAggregates()...)
+	return rv
+}
+
+func (this *Comprehension) clausesString() string {
+	rv := ""
+	for _, clause := range this.Overs {
+		rv = rv + " " + clause.String()
+	}
+	return rv
+}
+
+func hasAnyAliasPrefix(path string, aliases []string) bool {
+	for _, alias := range aliases {
+		if HasAliasPrefix(path, alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// [ expr OVER path AS identifier IF condition ] is the array of all
+// the matching values
+type ArrayComprehension struct {
+	Comprehension
+}
+
+func NewArrayComprehension(output Expression, overs []*ComprehensionOver) *ArrayComprehension {
+	return &ArrayComprehension{
+		Comprehension{
+			Output: output,
+			Overs:  overs,
+		},
+	}
+}
+
+func (this *ArrayComprehension) Evaluate(context Context) (interface{}, error) {
+	values, rv, ok, err := this.evaluate(context, -1)
+	if err != nil || !ok {
+		return rv, err
+	}
+	return values, nil
+}
+
+func (this *ArrayComprehension) String() string {
+	return fmt.Sprintf("[%v%v]", this.Output, this.clausesString())
+}
+
+// FIRST expr OVER path AS identifier IF condition is the first matching
+// value, MISSING when nothing matches
+type FirstComprehension struct {
+	Comprehension
+}
+
+func NewFirstComprehension(output Expression, overs []*ComprehensionOver) *FirstComprehension {
+	return &FirstComprehension{
+		Comprehension{
+			Output: output,
+			Overs:  overs,
+		},
+	}
+}
+
+func (this *FirstComprehension) Evaluate(context Context) (interface{}, error) {
+	values, rv, ok, err := this.evaluate(context, 1)
+	if err != nil || !ok {
+		return rv, err
+	}
+	if len(values) == 0 {
+		return MISSING, nil
+	}
+	return values[0], nil
+}
+
+func (this *FirstComprehension) String() string {
+	return fmt.Sprintf("FIRST %v%v", this.Output, this.clausesString())
+}
@@ -0,0 +1,118 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComprehensions(t *testing.T) {
+
+	bob := map[string]interface{}{"name": "bob", "age": 5.0, "toys": []interface{}{"ball"}}
+	jane := map[string]interface{}{"name": "jane", "age": 8.0, "toys": []interface{}{"kite", "doll"}}
+	sam := map[string]interface{}{"name": "sam", "age": 10.0}
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"age":      9.0,
+			"children": []interface{}{bob, jane, sam},
+			"empty":    []interface{}{},
+			"null":     nil,
+			"scalar":   "abc",
+		},
+	}
+
+	children := NewProperty("doc.children")
+	olderThan := func(age float64) BooleanExpression {
+		return NewGreaterThanOperator(NewProperty("c.age"), NewLiteralNumber(age))
+	}
+
+	tests := []struct {
+		input  Expression
+		output interface{}
+	}{
+		{NewArrayComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(children, "c", olderThan(6.0))}), []interface{}{jane, sam}},
+		{NewArrayComprehension(NewProperty("c.name"), []*ComprehensionOver{NewComprehensionOver(children, "c", nil)}), []interface{}{"bob", "jane", "sam"}},
+		{NewArrayComprehension(NewProperty("c.name"), []*ComprehensionOver{NewComprehensionOver(children, "c", olderThan(20.0))}), []interface{}{}},
+		{NewFirstComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(children, "c", olderThan(6.0))}), jane},
+		{NewFirstComprehension(NewProperty("c.name"), []*ComprehensionOver{NewComprehensionOver(children, "c", nil)}), "bob"},
+		{NewFirstComprehension(NewProperty("c.name"), []*ComprehensionOver{NewComprehensionOver(children, "c", olderThan(20.0))}), MISSING},
+
+		// the condition and output can refer to the rest of the document
+		{
+			NewArrayComprehension(NewProperty("c.name"), []*ComprehensionOver{
+				NewComprehensionOver(children, "c", NewGreaterThanOperator(NewProperty("doc.age"), NewProperty("c.age"))),
+			}),
+			[]interface{}{"bob", "jane"},
+		},
+
+		// MISSING values become null
+		{NewArrayComprehension(NewProperty("c.toys"), []*ComprehensionOver{NewComprehensionOver(children, "c", olderThan(6.0))}), []interface{}{jane["toys"], nil}},
+
+		// chained clauses are nested, inner paths that are not arrays contribute nothing
+		{
+			NewArrayComprehension(NewProperty("t"), []*ComprehensionOver{
+				NewComprehensionOver(children, "c", nil),
+				NewComprehensionOver(NewProperty("c.toys"), "t", nil),
+			}),
+			[]interface{}{"ball", "kite", "doll"},
+		},
+		{
+			NewArrayComprehension(NewLiteralArray([]Expression{NewProperty("c.name"), NewProperty("t")}), []*ComprehensionOver{
+				NewComprehensionOver(children, "c", olderThan(6.0)),
+				NewComprehensionOver(NewProperty("c.toys"), "t", NewNotEqualToOperator(NewProperty("t"), NewLiteralString("kite"))),
+			}),
+			[]interface{}{[]interface{}{"jane", "doll"}},
+		},
+		{
+			NewFirstComprehension(NewProperty("t"), []*ComprehensionOver{
+				NewComprehensionOver(children, "c", olderThan(6.0)),
+				NewComprehensionOver(NewProperty("c.toys"), "t", nil),
+			}),
+			"kite",
+		},
+
+		{NewArrayComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.empty"), "c", nil)}), []interface{}{}},
+		{NewFirstComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.empty"), "c", nil)}), MISSING},
+		{NewArrayComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.scalar"), "c", nil)}), nil},
+		{NewFirstComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.null"), "c", nil)}), nil},
+		{NewArrayComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.missing"), "c", nil)}), MISSING},
+		{NewFirstComprehension(NewProperty("c"), []*ComprehensionOver{NewComprehensionOver(NewProperty("doc.missing"), "c", nil)}), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestComprehensionReferencedProperties(t *testing.T) {
+
+	comprehension := NewArrayComprehension(NewLiteralArray([]Expression{NewProperty("c.name"), NewProperty("t"), NewProperty("doc.name")}), []*ComprehensionOver{
+		NewComprehensionOver(NewProperty("doc.children"), "c", NewGreaterThanOperator(NewProperty("c.age"), NewProperty("doc.age"))),
+		NewComprehensionOver(NewProperty("c.toys"), "t", nil),
+	})
+
+	expected := []Property{*NewProperty("doc.children"), *NewProperty("doc.age"), *NewProperty("doc.name")}
+	result := comprehension.ReferencedProperties()
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+}
@@ -33,9 +33,17 @@ func NewContext(raw map[string]interface{}) *RelativeContext {
 	}
 }
 
+// a new context resolving paths relative to path within this one
+// the receiver is not modified so it can still be used afterwards
 func (this *RelativeContext) GetSubContext(path string) Context {
-	this.relativeTo = this.relativeTo + "." + path
-	return this
+	relativeTo := path
+	if this.relativeTo != "" {
+		relativeTo = this.relativeTo + "." + path
+	}
+	return &RelativeContext{
+		raw:        this.raw,
+		relativeTo: relativeTo,
+	}
 }
 
 func (this *RelativeContext) GetPath(path string) (interface{}, error) {
@@ -44,19 +52,29 @@ func (this *RelativeContext) GetPath(path string) (interface{}, error) {
 	if this.relativeTo != "" {
 		accessPath = this.relativeTo + "." + path
 	}
+	return getPath(this.raw, accessPath)
+}
 
+// follow the path down from the value curr
+func getPath(curr interface{}, accessPath string) (interface{}, error) {
 	var currentPath = ""
-	var curr interface{}
-	curr = this.raw
 	for accessPath != "" {
 		headPath, headIndex, restPath, err := NextPathElement(accessPath)
 		if err != nil {
 			return nil, err
 		}
+		if curr == MISSING {
+			// everything beneath a missing value is missing
+			return MISSING, nil
+		}
 		if headPath != "" {
 			switch inside := curr.(type) {
 			case map[string]interface{}:
-				curr = inside[headPath]
+				value, ok := inside[headPath]
+				if !ok {
+					value = MISSING
+				}
+				curr = value
 				accessPath = restPath
 				currentPath = currentPath + "." + headPath
 			default:
@@ -65,7 +83,12 @@ func (this *RelativeContext) GetPath(path string) (interface{}, error) {
 		} else if headIndex != -1 {
 			switch inside := curr.(type) {
 			case []interface{}:
-				curr = inside[headIndex]
+				if headIndex < 0 || headIndex >= len(inside) {
+					// there is nothing at an index beyond the array
+					curr = MISSING
+				} else {
+					curr = inside[headIndex]
+				}
 				accessPath = restPath
 				currentPath = currentPath + "." + headPath
 			default:
@@ -79,6 +102,35 @@ func (this *RelativeContext) GetPath(path string) (interface{}, error) {
 	return curr, nil
 }
 
+// a ScopedContext binds an identifier to a value, paths beginning with
+// the identifier are resolved within that value and all other paths
+// are resolved by the enclosing context, so the identifier hides any
+// property of the same name
+type ScopedContext struct {
+	parent Context
+	name   string
+	value  interface{}
+}
+
+func NewScopedContext(parent Context, name string, value interface{}) *ScopedContext {
+	return &ScopedContext{
+		parent: parent,
+		name:   name,
+		value:  value,
+	}
+}
+
+func (this *ScopedContext) GetPath(path string) (interface{}, error) {
+	headPath, _, restPath, err := NextPathElement(path)
+	if err != nil {
+		return nil, err
+	}
+	if headPath != this.name {
+		return this.parent.GetPath(path)
+	}
+	return getPath(this.value, restPath)
+}
+
 func NextPathElement(path string) (headPath string, headIndex int, rest string, err error) {
 	dotIndex := strings.Index(path, ".")
 	lbIndex := strings.Index(path, "[")
@@ -81,10 +81,12 @@ func TestContextGetPath(t *testing.T) {
 	}{
 		{"name", Output{"will", false}},
 		{"address.city", Output{"New York", false}},
-		{"address.dne", Output{nil, false}},
-		{"address.dne.dne", Output{nil, true}},
+		{"address.dne", Output{MISSING, false}},
+		{"address.dne.dne", Output{MISSING, false}},
 		{"children[0].name", Output{"bob", false}},
 		{"children[1].name", Output{"jane", false}},
+		{"children[2].name", Output{MISSING, false}},
+		{"children[2]", Output{MISSING, false}},
 		{"children[1].name.xyz", Output{nil, true}},
 		{"children[abc]", Output{nil, true}},
 		{"address[0]", Output{nil, true}},
@@ -104,3 +106,99 @@ func TestContextGetPath(t *testing.T) {
 	}
 
 }
+
+func TestContextGetSubContext(t *testing.T) {
+
+	sampleContext := map[string]interface{}{
+		"name": "will",
+		"address": map[string]interface{}{
+			"city": "New York",
+			"geo": map[string]interface{}{
+				"lat": 40.7,
+			},
+		},
+	}
+
+	context := NewContext(sampleContext)
+	address := context.GetSubContext("address")
+	geo := address.(*RelativeContext).GetSubContext("geo")
+
+	tests := []struct {
+		context Context
+		input   string
+		output  interface{}
+	}{
+		{address, "city", "New York"},
+		{address, "geo.lat", 40.7},
+		{address, "name", MISSING},
+		{geo, "lat", 40.7},
+		// the original context is unchanged
+		{context, "name", "will"},
+	}
+
+	for _, x := range tests {
+		value, err := x.context.GetPath(x.input)
+		if err != nil {
+			t.Errorf("Expected no error, got error: %v", err)
+		}
+		if !reflect.DeepEqual(value, x.output) {
+			t.Errorf("Expected value %v for %v, got %v", x.output, x.input, value)
+		}
+	}
+
+}
+
+func TestScopedContextGetPath(t *testing.T) {
+
+	type Output struct {
+		value interface{}
+		err   bool
+	}
+
+	sampleContext := map[string]interface{}{
+		"name":  "will",
+		"child": "not the child",
+	}
+
+	child := map[string]interface{}{
+		"name": "bob",
+		"toys": []interface{}{"ball", "kite"},
+	}
+
+	tests := []struct {
+		input  string
+		output Output
+	}{
+		{"child", Output{child, false}},
+		{"child.name", Output{"bob", false}},
+		{"child.toys[1]", Output{"kite", false}},
+		{"child.dne", Output{MISSING, false}},
+		{"child.name.xyz", Output{nil, true}},
+		{"name", Output{"will", false}},
+		{"children", Output{MISSING, false}},
+	}
+
+	context := NewScopedContext(NewContext(sampleContext), "child", child)
+
+	for _, x := range tests {
+		value, err := context.GetPath(x.input)
+		if err != nil && !x.output.err {
+			t.Errorf("Expected no error, got error: %v", err)
+		}
+		if !reflect.DeepEqual(value, x.output.value) {
+			t.Errorf("Expected value %v for %v, got %v", x.output.value, x.input, value)
+		}
+	}
+
+	// nested scopes resolve the innermost identifier first
+	nested := NewScopedContext(context, "toy", "ball")
+	value, err := nested.GetPath("toy")
+	if err != nil || value != "ball" {
+		t.Errorf("Expected ball, got %v, %v", value, err)
+	}
+	value, err = nested.GetPath("child.name")
+	if err != nil || value != "bob" {
+		t.Errorf("Expected bob, got %v, %v", value, err)
+	}
+
+}
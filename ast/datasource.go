@@ -9,20 +9,122 @@
 
 package ast
 
+import (
+	"strings"
+)
+
+// rows produced from a data source contain the document under this key
+// paths using the alias of the data source are resolved to it
+const DOCUMENT_KEY = "doc"
+
 type DataSource interface {
 	GetName() string
+	GetPath() string
+	GetAlias() string
 }
 
+// a NamedDataSource with a path makes the value at that path
+// within each document the input to the query instead
 type NamedDataSource struct {
-	name string
+	name  string
+	path  string
+	alias string
 }
 
+// without an AS clause the data source is referred to by its name
 func NewNamedDataSource(name string) *NamedDataSource {
 	return &NamedDataSource{
-		name: name,
+		name:  name,
+		alias: name,
+	}
+}
+
+func NewNamedDataSourceWithAlias(name string, alias string) *NamedDataSource {
+	return &NamedDataSource{
+		name:  name,
+		alias: alias,
+	}
+}
+
+// without an AS clause a path is referred to by its last element
+func NewNamedDataSourceWithPath(name string, path string, alias string) *NamedDataSource {
+	if alias == "" {
+		alias = lastPathElement(path)
+	}
+	return &NamedDataSource{
+		name:  name,
+		path:  path,
+		alias: alias,
 	}
 }
 
 func (this *NamedDataSource) GetName() string {
 	return this.name
 }
+
+func (this *NamedDataSource) GetPath() string {
+	return this.path
+}
+
+func (this *NamedDataSource) GetAlias() string {
+	return this.alias
+}
+
+func (this *NamedDataSource) String() string {
+	rv := this.name
+	if this.path != "" {
+		rv = rv + "." + this.path
+	}
+	if this.alias != this.name {
+		rv = rv + " AS " + this.alias
+	}
+	return rv
+}
+
+// an OverDataSource joins each input object with every element
+// of the array found at its path, the element is known by the alias
+type OverDataSource struct {
+	property *Property
+	alias    string
+}
+
+func NewOverDataSource(property *Property, alias string) *OverDataSource {
+	return &OverDataSource{
+		property: property,
+		alias:    alias,
+	}
+}
+
+// the right hand side of OVER is not a bucket
+func (this *OverDataSource) GetName() string {
+	return ""
+}
+
+func (this *OverDataSource) GetPath() string {
+	return this.property.Path
+}
+
+func (this *OverDataSource) GetProperty() *Property {
+	return this.property
+}
+
+func (this *OverDataSource) GetAlias() string {
+	return this.alias
+}
+
+func (this *OverDataSource) String() string {
+	return "OVER " + this.property.Path + " AS " + this.alias
+}
+
+// rewrite a path beginning with alias to begin with DOCUMENT_KEY instead
+// paths not beginning with alias are returned unchanged
+func ResolveAlias(path string, alias string) string {
+	if HasAliasPrefix(path, alias) {
+		return DOCUMENT_KEY + path[len(alias):]
+	}
+	return path
+}
+
+func HasAliasPrefix(path string, alias string) bool {
+	return path == alias || strings.HasPrefix(path, alias+".") || strings.HasPrefix(path, alias+"[")
+}
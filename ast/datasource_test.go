@@ -0,0 +1,77 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"testing"
+)
+
+func TestResolveAlias(t *testing.T) {
+
+	tests := []struct {
+		path   string
+		alias  string
+		output string
+	}{
+		{"b.abv", "b", "doc.abv"},
+		{"b.address.city", "b", "doc.address.city"},
+		{"b[0]", "b", "doc[0]"},
+		{"b", "b", "doc"},
+		{"beer.abv", "b", "beer.abv"},
+		{"doc.abv", "b", "doc.abv"},
+		{"beer-sample.abv", "beer-sample", "doc.abv"},
+		{"meta.id", "beer-sample", "meta.id"},
+	}
+
+	for _, x := range tests {
+		result := ResolveAlias(x.path, x.alias)
+		if result != x.output {
+			t.Errorf("Expected %v for %v with alias %v, got %v", x.output, x.path, x.alias, result)
+		}
+	}
+
+}
+
+func TestNamedDataSource(t *testing.T) {
+
+	tests := []struct {
+		input  *NamedDataSource
+		name   string
+		path   string
+		alias  string
+		output string
+	}{
+		{NewNamedDataSource("beer-sample"), "beer-sample", "", "beer-sample", "beer-sample"},
+		{NewNamedDataSourceWithAlias("beer-sample", "b"), "beer-sample", "", "b", "beer-sample AS b"},
+		{NewNamedDataSourceWithPath("organizations", "address", ""), "organizations", "address", "address", "organizations.address AS address"},
+		{NewNamedDataSourceWithPath("orders", "lines[0].item", "item"), "orders", "lines[0].item", "item", "orders.lines[0].item AS item"},
+		{NewNamedDataSourceWithPath("orders", "lines[0]", ""), "orders", "lines[0]", "lines", "orders.lines[0] AS lines"},
+	}
+
+	for _, x := range tests {
+		if x.input.GetName() != x.name || x.input.GetPath() != x.path || x.input.GetAlias() != x.alias {
+			t.Errorf("Expected %v %v %v, got %v %v %v", x.name, x.path, x.alias, x.input.GetName(), x.input.GetPath(), x.input.GetAlias())
+		}
+		if x.input.String() != x.output {
+			t.Errorf("Expected %v, got %v", x.output, x.input.String())
+		}
+	}
+
+}
+
+func TestOverDataSource(t *testing.T) {
+	over := NewOverDataSource(NewProperty("organization.employees"), "employee")
+	if over.GetName() != "" || over.GetPath() != "organization.employees" || over.GetAlias() != "employee" {
+		t.Errorf("Unexpected over data source %v", over)
+	}
+	if over.String() != "OVER organization.employees AS employee" {
+		t.Errorf("Expected OVER organization.employees AS employee, got %v", over.String())
+	}
+}
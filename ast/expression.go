@@ -12,4 +12,5 @@ package ast
 type Expression interface {
 	Evaluate(context Context) (interface{}, error)
 	ReferencedProperties() []Property
+	ReferencedAggregates() []AggregateFunction
 }
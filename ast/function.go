@@ -0,0 +1,706 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"code.google.com/p/go.exp/locale/collate"
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// a FunctionImplementation computes the value of a scalar function
+// from the values of its arguments, the context is only needed
+// by functions like META() and VALUE() that take no arguments
+type FunctionImplementation func(context Context, arguments []interface{}) (interface{}, error)
+
+type functionDefinition struct {
+	minArgs        int
+	maxArgs        int // -1 means no limit
+	implementation FunctionImplementation
+}
+
+// function names are case-insensitive, they are stored in upper case
+var functionRegistry = map[string]*functionDefinition{}
+
+// RegisterFunction makes a scalar function available to queries
+// maxArgs of -1 allows any number of arguments
+func RegisterFunction(name string, minArgs, maxArgs int, implementation FunctionImplementation) {
+	functionRegistry[strings.ToUpper(name)] = &functionDefinition{
+		minArgs:        minArgs,
+		maxArgs:        maxArgs,
+		implementation: implementation,
+	}
+}
+
+func init() {
+	RegisterFunction("CEIL", 1, 1, ceilFunction)
+	RegisterFunction("FLOOR", 1, 1, floorFunction)
+	RegisterFunction("GREATEST", 1, -1, greatestFunction)
+	RegisterFunction("LEAST", 1, -1, leastFunction)
+	RegisterFunction("IFMISSING", 1, -1, ifMissingFunction)
+	RegisterFunction("IFNULL", 1, -1, ifNullFunction)
+	RegisterFunction("IFMISSINGORNULL", 1, -1, ifMissingOrNullFunction)
+	RegisterFunction("NULLIF", 2, 2, nullIfFunction)
+	RegisterFunction("MISSINGIF", 2, 2, missingIfFunction)
+	RegisterFunction("LENGTH", 1, 1, lengthFunction)
+	RegisterFunction("LOWER", 1, 1, lowerFunction)
+	RegisterFunction("UPPER", 1, 1, upperFunction)
+	RegisterFunction("LTRIM", 1, 2, ltrimFunction)
+	RegisterFunction("RTRIM", 1, 2, rtrimFunction)
+	RegisterFunction("TRIM", 1, 2, trimFunction)
+	RegisterFunction("SUBSTR", 2, 3, substrFunction)
+	RegisterFunction("CONTAINS", 2, 2, containsFunction)
+	RegisterFunction("STARTS_WITH", 2, 2, startsWithFunction)
+	RegisterFunction("ENDS_WITH", 2, 2, endsWithFunction)
+	RegisterFunction("POSITION", 2, 2, positionFunction)
+	RegisterFunction("REPLACE", 3, 3, replaceFunction)
+	RegisterFunction("SPLIT", 1, 2, splitFunction)
+	RegisterFunction("REGEXP_MATCH", 2, 2, regexpMatchFunction)
+	RegisterFunction("OBJECT_KEYS", 1, 1, objectKeysFunction)
+	RegisterFunction("OBJECT_VALUES", 1, 1, objectValuesFunction)
+	RegisterFunction("OBJECT_PAIRS", 1, 1, objectPairsFunction)
+	RegisterFunction("ROUND", 1, 2, roundFunction)
+	RegisterFunction("TRUNC", 1, 2, truncFunction)
+	RegisterFunction("META", 0, 0, metaFunction)
+	RegisterFunction("VALUE", 0, 0, valueFunction)
+}
+
+func IsAggregateFunctionName(name string) bool {
+	switch strings.ToUpper(name) {
+	case "COUNT", "SUM", "AVG", "MIN", "MAX":
+		return true
+	}
+	return false
+}
+
+type FunctionCall struct {
+	Name     string
+	Operands []Expression
+	function *functionDefinition
+}
+
+func NewFunctionCall(name string, operands []Expression) (*FunctionCall, error) {
+	upperName := strings.ToUpper(name)
+	function, ok := functionRegistry[upperName]
+	if !ok {
+		if IsAggregateFunctionName(upperName) {
+			return nil, fmt.Errorf("Aggregate function %v requires exactly one argument", upperName)
+		}
+		return nil, fmt.Errorf("Unsupported function %v", name)
+	}
+	if len(operands) < function.minArgs {
+		return nil, fmt.Errorf("Function %v requires at least %d argument(s)", upperName, function.minArgs)
+	}
+	if function.maxArgs != -1 && len(operands) > function.maxArgs {
+		return nil, fmt.Errorf("Function %v accepts at most %d argument(s)", upperName, function.maxArgs)
+	}
+	return &FunctionCall{
+		Name:     upperName,
+		Operands: operands,
+		function: function,
+	}, nil
+}
+
+func (this *FunctionCall) Evaluate(context Context) (interface{}, error) {
+	arguments := make([]interface{}, len(this.Operands))
+	for i, operand := range this.Operands {
+		value, err := operand.Evaluate(context)
+		if err != nil {
+			return nil, err
+		}
+		arguments[i] = value
+	}
+	return this.function.implementation(context, arguments)
+}
+
+func (this *FunctionCall) String() string {
+	operands := make([]string, len(this.Operands))
+	for i, operand := range this.Operands {
+		operands[i] = fmt.Sprintf("%v", operand)
+	}
+	return fmt.Sprintf("%v(%v)", this.Name, strings.Join(operands, ", "))
+}
+
+// META() and VALUE() read the meta data and the document of the row
+func (this *FunctionCall) ReferencedProperties() []Property {
+	rv := make([]Property, 0)
+	for _, operand := range this.Operands {
+		rv = append(rv, operand.ReferencedProperties()...)
+	}
+	switch this.Name {
+	case "META":
+		rv = append(rv, *NewProperty("meta"))
+	case "VALUE":
+		rv = append(rv, *NewProperty(DOCUMENT_KEY))
+	}
+	return rv
+}
+
+func (this *FunctionCall) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0)
+	for _, operand := range this.Operands {
+		rv = append(rv, operand.ReferencedAggregates()...)
+	}
+	return rv
+}
+
+// functions returning booleans like CONTAINS() can be used as conditions
+
+func (this *FunctionCall) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+func (this *FunctionCall) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *FunctionCall) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *FunctionCall) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *FunctionCall) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *FunctionCall) IsSargable() bool {
+	return false
+}
+
+func (this *FunctionCall) GetSargProperty() *Property {
+	return nil
+}
+
+func (this *FunctionCall) GetSargValue() (interface{}, error) {
+	return nil, nil
+}
+
+func (this *FunctionCall) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	return 1.0 / 3.0
+}
+
+// numeric functions
+
+func ceilFunction(context Context, arguments []interface{}) (interface{}, error) {
+	switch value := arguments[0].(type) {
+	case float64:
+		return math.Ceil(value), nil
+	}
+	return nil, nil
+}
+
+func floorFunction(context Context, arguments []interface{}) (interface{}, error) {
+	switch value := arguments[0].(type) {
+	case float64:
+		return math.Floor(value), nil
+	}
+	return nil, nil
+}
+
+func roundFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return applyWithDigits(arguments, func(value float64) float64 {
+		// round half away from zero
+		if value < 0 {
+			return -math.Floor(-value + 0.5)
+		}
+		return math.Floor(value + 0.5)
+	})
+}
+
+func truncFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return applyWithDigits(arguments, math.Trunc)
+}
+
+// apply f to the value shifted by the optional number of digits
+// the value must be numeric and the digits must be an integer
+func applyWithDigits(arguments []interface{}, f func(float64) float64) (interface{}, error) {
+	value, ok := arguments[0].(float64)
+	if !ok {
+		return nil, nil
+	}
+	digits := 0.0
+	if len(arguments) > 1 {
+		digits, ok = arguments[1].(float64)
+		if !ok || digits != math.Trunc(digits) {
+			return nil, nil
+		}
+	}
+	// always scale by a whole power of 10 to limit rounding errors
+	if digits < 0 {
+		scale := math.Pow(10, -digits)
+		return f(value/scale) * scale, nil
+	}
+	scale := math.Pow(10, digits)
+	return f(value*scale) / scale, nil
+}
+
+// comparison functions
+
+func greatestFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return collateArguments(arguments, 1), nil
+}
+
+func leastFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return collateArguments(arguments, -1), nil
+}
+
+// returns the non-NULL, non-MISSING argument that collates first
+// (keep -1) or last (keep 1), NULL if there is no such argument
+func collateArguments(arguments []interface{}, keep int) interface{} {
+	var rv interface{}
+	for _, argument := range arguments {
+		if argument == nil || argument == MISSING {
+			continue
+		}
+		if rv == nil || CollateJSON(argument, rv)*keep > 0 {
+			rv = argument
+		}
+	}
+	return rv
+}
+
+// conditional functions
+
+func ifMissingFunction(context Context, arguments []interface{}) (interface{}, error) {
+	for _, argument := range arguments {
+		if argument != MISSING {
+			return argument, nil
+		}
+	}
+	return MISSING, nil
+}
+
+func ifNullFunction(context Context, arguments []interface{}) (interface{}, error) {
+	for _, argument := range arguments {
+		if argument != nil {
+			return argument, nil
+		}
+	}
+	return nil, nil
+}
+
+func ifMissingOrNullFunction(context Context, arguments []interface{}) (interface{}, error) {
+	for _, argument := range arguments {
+		if argument != nil && argument != MISSING {
+			return argument, nil
+		}
+	}
+	return nil, nil
+}
+
+func nullIfFunction(context Context, arguments []interface{}) (interface{}, error) {
+	if argumentsEqual(arguments[0], arguments[1]) {
+		return nil, nil
+	}
+	return arguments[0], nil
+}
+
+func missingIfFunction(context Context, arguments []interface{}) (interface{}, error) {
+	if argumentsEqual(arguments[0], arguments[1]) {
+		return MISSING, nil
+	}
+	return arguments[0], nil
+}
+
+// same meaning as the = operator, comparing
+// with NULL or MISSING is never true
+func argumentsEqual(left, right interface{}) bool {
+	if left == nil || right == nil || left == MISSING || right == MISSING {
+		return false
+	}
+	return sameType(left, right) && CollateJSON(left, right) == 0
+}
+
+// string functions
+
+func lengthFunction(context Context, arguments []interface{}) (interface{}, error) {
+	switch value := arguments[0].(type) {
+	case string:
+		return float64(utf8.RuneCountInString(value)), nil
+	case []interface{}:
+		return float64(len(value)), nil
+	case map[string]interface{}:
+		return float64(len(value)), nil
+	}
+	return nil, nil
+}
+
+func lowerFunction(context Context, arguments []interface{}) (interface{}, error) {
+	switch value := arguments[0].(type) {
+	case string:
+		return strings.ToLower(value), nil
+	}
+	return nil, nil
+}
+
+func upperFunction(context Context, arguments []interface{}) (interface{}, error) {
+	switch value := arguments[0].(type) {
+	case string:
+		return strings.ToUpper(value), nil
+	}
+	return nil, nil
+}
+
+func ltrimFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return trimWith(arguments, strings.TrimLeft)
+}
+
+func rtrimFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return trimWith(arguments, strings.TrimRight)
+}
+
+func trimFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return trimWith(arguments, strings.Trim)
+}
+
+// the character set defaults to whitespace when not specified
+func trimWith(arguments []interface{}, trim func(string, string) string) (interface{}, error) {
+	value, ok := arguments[0].(string)
+	if !ok {
+		return nil, nil
+	}
+	characters := " \t\n\r"
+	if len(arguments) > 1 {
+		characters, ok = arguments[1].(string)
+		if !ok {
+			return nil, nil
+		}
+	}
+	return trim(value, characters), nil
+}
+
+func substrFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, ok := arguments[0].(string)
+	if !ok {
+		return nil, nil
+	}
+	position, ok := arguments[1].(float64)
+	if !ok || position != math.Trunc(position) {
+		return nil, nil
+	}
+
+	// positions count characters, starting at 1
+	// negative positions count from the end
+	runes := []rune(value)
+	start := 0
+	if position > 0 {
+		start = int(position) - 1
+	} else if position < 0 {
+		start = len(runes) + int(position)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+
+	end := len(runes)
+	if len(arguments) > 2 {
+		length, ok := arguments[2].(float64)
+		if !ok || length != math.Trunc(length) || length <= 0 {
+			return nil, nil
+		}
+		if start+int(length) < end {
+			end = start + int(length)
+		}
+	}
+	return string(runes[start:end]), nil
+}
+
+// the searching string functions match characters the way CollateJSON
+// compares strings, so for example a precomposed character matches
+// the same character written with a combining accent
+
+func containsFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, search, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	start, _ := collateIndex([]rune(value), search, 0)
+	return start >= 0, nil
+}
+
+func startsWithFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, prefix, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	if strings.HasPrefix(value, prefix) {
+		return true, nil
+	}
+	runes := []rune(value)
+	matcher := newCollateMatcher(prefix)
+	for end := matcher.minLength; end <= matcher.maxLength && end <= len(runes); end++ {
+		if matcher.matches(runes[0:end]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func endsWithFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, suffix, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	if strings.HasSuffix(value, suffix) {
+		return true, nil
+	}
+	runes := []rune(value)
+	matcher := newCollateMatcher(suffix)
+	for length := matcher.minLength; length <= matcher.maxLength && length <= len(runes); length++ {
+		if matcher.matches(runes[len(runes)-length:]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// positions count characters starting at 1, like SUBSTR
+// 0 means the string was not found
+func positionFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, search, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	start, _ := collateIndex([]rune(value), search, 0)
+	return float64(start + 1), nil
+}
+
+func replaceFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, search, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	replacement, ok := arguments[2].(string)
+	if !ok {
+		return nil, nil
+	}
+	if search == "" {
+		return value, nil
+	}
+	runes := []rune(value)
+	rv := ""
+	from := 0
+	for {
+		start, end := collateIndex(runes, search, from)
+		if start < 0 {
+			break
+		}
+		rv = rv + string(runes[from:start]) + replacement
+		from = end
+	}
+	return rv + string(runes[from:]), nil
+}
+
+// without a separator the string is split around whitespace
+func splitFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, ok := arguments[0].(string)
+	if !ok {
+		return nil, nil
+	}
+	rv := make([]interface{}, 0)
+	if len(arguments) < 2 {
+		for _, field := range strings.Fields(value) {
+			rv = append(rv, field)
+		}
+		return rv, nil
+	}
+	separator, ok := arguments[1].(string)
+	if !ok {
+		return nil, nil
+	}
+	runes := []rune(value)
+	if separator == "" {
+		// every character on its own
+		for _, r := range runes {
+			rv = append(rv, string(r))
+		}
+		return rv, nil
+	}
+	from := 0
+	for {
+		start, end := collateIndex(runes, separator, from)
+		if start < 0 {
+			break
+		}
+		rv = append(rv, string(runes[from:start]))
+		from = end
+	}
+	return append(rv, string(runes[from:])), nil
+}
+
+// the pattern is a Go regular expression which must match the whole string
+// an invalid pattern is NULL like any other argument of the wrong type
+func regexpMatchFunction(context Context, arguments []interface{}) (interface{}, error) {
+	value, pattern, ok := stringArguments(arguments)
+	if !ok {
+		return nil, nil
+	}
+	re := compileRegexp(pattern)
+	if re == nil {
+		return nil, nil
+	}
+	return re.MatchString(value), nil
+}
+
+// the number of compiled patterns kept, patterns are usually literals
+// so only a few are seen, the cache is emptied when it is full to
+// bound the memory used by patterns computed from the documents
+const REGEXP_CACHE_SIZE = 1024
+
+var regexpCache = map[string]*regexp.Regexp{}
+var regexpCacheMutex sync.Mutex
+
+// compile the pattern once and reuse it for every row
+// an invalid pattern is cached as nil
+func compileRegexp(pattern string) *regexp.Regexp {
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+
+	re, ok := regexpCache[pattern]
+	if ok {
+		return re
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		re = nil
+	}
+	if len(regexpCache) >= REGEXP_CACHE_SIZE {
+		regexpCache = map[string]*regexp.Regexp{}
+	}
+	regexpCache[pattern] = re
+	return re
+}
+
+func stringArguments(arguments []interface{}) (string, string, bool) {
+	first, ok := arguments[0].(string)
+	if !ok {
+		return "", "", false
+	}
+	second, ok := arguments[1].(string)
+	if !ok {
+		return "", "", false
+	}
+	return first, second, true
+}
+
+// the character positions [start, end) of the first part of runes at or
+// after from that collates equal to search, -1, -1 when there is none
+func collateIndex(runes []rune, search string, from int) (int, int) {
+	// most of the time the exact characters are there
+	index := strings.Index(string(runes[from:]), search)
+	if index >= 0 {
+		start := from + utf8.RuneCountInString(string(runes[from:])[0:index])
+		return start, start + utf8.RuneCountInString(search)
+	}
+	matcher := newCollateMatcher(search)
+	for start := from; start+matcher.minLength <= len(runes); start++ {
+		for end := start + matcher.minLength; end <= start+matcher.maxLength && end <= len(runes); end++ {
+			if matcher.matches(runes[start:end]) {
+				return start, end
+			}
+		}
+	}
+	return -1, -1
+}
+
+// a character decomposes into at most 4 characters, so text collating
+// equal to a string has at most 4 times (and at least a quarter of)
+// as many characters, text padded with ignorable characters is not found
+const MAX_DECOMPOSITION = 4
+
+// collateMatcher finds text collating equal to a string by comparing
+// collation keys, the key of the string is only computed once
+type collateMatcher struct {
+	key       []byte
+	buffer    collate.Buffer
+	minLength int
+	maxLength int
+}
+
+func newCollateMatcher(search string) *collateMatcher {
+	rv := &collateMatcher{}
+	rv.key = append([]byte{}, icuCollator.KeyFromString(&rv.buffer, search)...)
+	length := utf8.RuneCountInString(search)
+	rv.minLength = (length + MAX_DECOMPOSITION - 1) / MAX_DECOMPOSITION
+	rv.maxLength = length * MAX_DECOMPOSITION
+	return rv
+}
+
+func (this *collateMatcher) matches(runes []rune) bool {
+	this.buffer.Reset()
+	return bytes.Equal(icuCollator.KeyFromString(&this.buffer, string(runes)), this.key)
+}
+
+// object functions, the results are ordered by field name
+
+func objectKeysFunction(context Context, arguments []interface{}) (interface{}, error) {
+	object, ok := arguments[0].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	keys := sortedKeys(object)
+	rv := make([]interface{}, len(keys))
+	for i, key := range keys {
+		rv[i] = key
+	}
+	return rv, nil
+}
+
+func objectValuesFunction(context Context, arguments []interface{}) (interface{}, error) {
+	object, ok := arguments[0].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	keys := sortedKeys(object)
+	rv := make([]interface{}, len(keys))
+	for i, key := range keys {
+		rv[i] = object[key]
+	}
+	return rv, nil
+}
+
+func objectPairsFunction(context Context, arguments []interface{}) (interface{}, error) {
+	object, ok := arguments[0].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	keys := sortedKeys(object)
+	rv := make([]interface{}, len(keys))
+	for i, key := range keys {
+		rv[i] = map[string]interface{}{
+			"name":  key,
+			"value": object[key],
+		}
+	}
+	return rv, nil
+}
+
+// document functions
+
+func metaFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return context.GetPath("meta")
+}
+
+func valueFunction(context Context, arguments []interface{}) (interface{}, error) {
+	return context.GetPath(DOCUMENT_KEY)
+}
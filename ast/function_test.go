@@ -0,0 +1,272 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mustNewFunctionCall(name string, operands []Expression) *FunctionCall {
+	rv, err := NewFunctionCall(name, operands)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+func TestFunctions(t *testing.T) {
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"name":  "Mountain View",
+			"abv":   7.25,
+			"tags":  []interface{}{"a", "b", "c"},
+			"empty": nil,
+		},
+		"meta": map[string]interface{}{
+			"id": "first",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		operands []Expression
+		output   interface{}
+	}{
+		{"CEIL", []Expression{NewLiteralNumber(7.25)}, 8.0},
+		{"CEIL", []Expression{NewLiteralNumber(-7.25)}, -7.0},
+		{"CEIL", []Expression{NewLiteralString("7.25")}, nil},
+		{"FLOOR", []Expression{NewLiteralNumber(7.25)}, 7.0},
+		{"FLOOR", []Expression{NewLiteralNumber(-7.25)}, -8.0},
+		{"FLOOR", []Expression{NewLiteralNull()}, nil},
+
+		{"ROUND", []Expression{NewLiteralNumber(7.5)}, 8.0},
+		{"ROUND", []Expression{NewLiteralNumber(-7.5)}, -8.0},
+		{"ROUND", []Expression{NewProperty("doc.abv"), NewLiteralNumber(1.0)}, 7.3},
+		{"ROUND", []Expression{NewLiteralNumber(1250.0), NewLiteralNumber(-2.0)}, 1300.0},
+		{"ROUND", []Expression{NewLiteralNumber(7.5), NewLiteralNumber(0.5)}, nil},
+		{"ROUND", []Expression{NewLiteralString("7.5")}, nil},
+		{"TRUNC", []Expression{NewLiteralNumber(-7.5)}, -7.0},
+		{"TRUNC", []Expression{NewProperty("doc.abv"), NewLiteralNumber(1.0)}, 7.2},
+		{"TRUNC", []Expression{NewLiteralNumber(7.5), NewLiteralString("1")}, nil},
+
+		{"GREATEST", []Expression{NewLiteralNumber(1.0), NewLiteralNumber(3.0), NewLiteralNumber(2.0)}, 3.0},
+		{"GREATEST", []Expression{NewLiteralNull(), NewLiteralNumber(1.0), NewProperty("doc.missing")}, 1.0},
+		{"GREATEST", []Expression{NewLiteralNull(), NewProperty("doc.missing")}, nil},
+		{"GREATEST", []Expression{NewLiteralNumber(1.0), NewLiteralString("a")}, "a"},
+		{"LEAST", []Expression{NewLiteralNumber(2.0), NewLiteralNumber(1.0), NewLiteralNull()}, 1.0},
+		{"LEAST", []Expression{NewLiteralNull()}, nil},
+		{"LEAST", []Expression{NewProperty("doc.missing"), NewLiteralString("a")}, "a"},
+
+		{"IFNULL", []Expression{NewProperty("doc.empty"), NewLiteralString("default")}, "default"},
+		{"IFNULL", []Expression{NewProperty("doc.name"), NewLiteralString("default")}, "Mountain View"},
+		{"IFNULL", []Expression{NewProperty("doc.missing"), NewLiteralString("default")}, MISSING},
+		{"IFMISSING", []Expression{NewProperty("doc.missing"), NewLiteralNumber(1.0)}, 1.0},
+		{"IFMISSING", []Expression{NewProperty("doc.empty"), NewLiteralNumber(1.0)}, nil},
+		{"IFMISSING", []Expression{NewProperty("doc.missing")}, MISSING},
+		{"IFMISSINGORNULL", []Expression{NewProperty("doc.missing"), NewProperty("doc.empty"), NewLiteralNumber(2.0)}, 2.0},
+		{"IFMISSINGORNULL", []Expression{NewProperty("doc.missing"), NewProperty("doc.empty")}, nil},
+		{"NULLIF", []Expression{NewProperty("doc.abv"), NewLiteralNumber(7.25)}, nil},
+		{"NULLIF", []Expression{NewProperty("doc.abv"), NewLiteralNumber(5.0)}, 7.25},
+		{"NULLIF", []Expression{NewProperty("doc.abv"), NewLiteralNull()}, 7.25},
+		{"NULLIF", []Expression{NewProperty("doc.missing"), NewProperty("doc.missing")}, MISSING},
+		{"MISSINGIF", []Expression{NewProperty("doc.abv"), NewLiteralNumber(7.25)}, MISSING},
+		{"MISSINGIF", []Expression{NewProperty("doc.abv"), NewLiteralNumber(5.0)}, 7.25},
+
+		{"LENGTH", []Expression{NewProperty("doc.name")}, 13.0},
+		{"LENGTH", []Expression{NewLiteralString("héllo")}, 5.0},
+		{"LENGTH", []Expression{NewProperty("doc.tags")}, 3.0},
+		{"LENGTH", []Expression{NewProperty("meta")}, 1.0},
+		{"LENGTH", []Expression{NewProperty("doc.abv")}, nil},
+		{"LOWER", []Expression{NewProperty("doc.name")}, "mountain view"},
+		{"LOWER", []Expression{NewProperty("doc.abv")}, nil},
+		{"UPPER", []Expression{NewProperty("doc.name")}, "MOUNTAIN VIEW"},
+		{"UPPER", []Expression{NewProperty("doc.tags")}, nil},
+
+		{"LTRIM", []Expression{NewLiteralString("xxyabcxy"), NewLiteralString("xy")}, "abcxy"},
+		{"RTRIM", []Expression{NewLiteralString("xxyabcxy"), NewLiteralString("xy")}, "xxyabc"},
+		{"TRIM", []Expression{NewLiteralString("xxyabcxy"), NewLiteralString("xy")}, "abc"},
+		{"TRIM", []Expression{NewLiteralString("  abc ")}, "abc"},
+		{"TRIM", []Expression{NewLiteralNumber(1.0), NewLiteralString("xy")}, nil},
+		{"TRIM", []Expression{NewLiteralString("abc"), NewLiteralNumber(1.0)}, nil},
+
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(10.0)}, "View"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(0.0)}, "Mountain View"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(1.0), NewLiteralNumber(8.0)}, "Mountain"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(-4.0)}, "View"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(-4.0), NewLiteralNumber(2.0)}, "Vi"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(-100.0), NewLiteralNumber(3.0)}, "Mou"},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(100.0)}, ""},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(1.5)}, nil},
+		{"SUBSTR", []Expression{NewProperty("doc.name"), NewLiteralNumber(1.0), NewLiteralNumber(0.0)}, nil},
+		{"SUBSTR", []Expression{NewProperty("doc.abv"), NewLiteralNumber(1.0)}, nil},
+
+		{"CONTAINS", []Expression{NewProperty("doc.name"), NewLiteralString("tain")}, true},
+		{"CONTAINS", []Expression{NewProperty("doc.name"), NewLiteralString("")}, true},
+		{"CONTAINS", []Expression{NewProperty("doc.name"), NewLiteralString("tian")}, false},
+		{"CONTAINS", []Expression{NewLiteralString("café"), NewLiteralString("cafe\u0301")}, true},
+		{"CONTAINS", []Expression{NewLiteralString("un cafe\u0301 noir"), NewLiteralString("é n")}, true},
+		{"CONTAINS", []Expression{NewLiteralString("un cafe\u0301 noir"), NewLiteralString("é b")}, false},
+		{"CONTAINS", []Expression{NewProperty("doc.abv"), NewLiteralString("7")}, nil},
+		{"CONTAINS", []Expression{NewProperty("doc.name"), NewProperty("doc.missing")}, nil},
+		{"STARTS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("Mount")}, true},
+		{"STARTS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("View")}, false},
+		{"STARTS_WITH", []Expression{NewLiteralString("e\u0301clair"), NewLiteralString("é")}, true},
+		{"STARTS_WITH", []Expression{NewLiteralString("éclair"), NewLiteralString("e\u0301cl")}, true},
+		{"STARTS_WITH", []Expression{NewLiteralString("éclair"), NewLiteralString("e\u0301t")}, false},
+		{"STARTS_WITH", []Expression{NewProperty("doc.tags"), NewLiteralString("a")}, nil},
+		{"ENDS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("View")}, true},
+		{"ENDS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("Mount")}, false},
+		{"ENDS_WITH", []Expression{NewLiteralString("cafe\u0301"), NewLiteralString("é")}, true},
+		{"ENDS_WITH", []Expression{NewLiteralString("café"), NewLiteralString("fe\u0301")}, true},
+		{"ENDS_WITH", []Expression{NewLiteralString("café"), NewLiteralString("e\u0301e")}, false},
+		{"POSITION", []Expression{NewProperty("doc.name"), NewLiteralString("View")}, 10.0},
+		{"POSITION", []Expression{NewLiteralString("héllo wörld"), NewLiteralString("wö")}, 7.0},
+		{"POSITION", []Expression{NewProperty("doc.name"), NewLiteralString("view")}, 0.0},
+		{"POSITION", []Expression{NewProperty("doc.name"), NewLiteralNumber(1.0)}, nil},
+		{"REPLACE", []Expression{NewProperty("doc.name"), NewLiteralString("n"), NewLiteralString("N")}, "MouNtaiN View"},
+		{"REPLACE", []Expression{NewLiteralString("aaa"), NewLiteralString("aa"), NewLiteralString("b")}, "ba"},
+		{"REPLACE", []Expression{NewLiteralString("abc"), NewLiteralString(""), NewLiteralString("x")}, "abc"},
+		{"REPLACE", []Expression{NewLiteralString("abc"), NewLiteralString("b"), NewLiteralNull()}, nil},
+		{"SPLIT", []Expression{NewLiteralString(" a  b\tc ")}, []interface{}{"a", "b", "c"}},
+		{"SPLIT", []Expression{NewLiteralString("a,b,,c"), NewLiteralString(",")}, []interface{}{"a", "b", "", "c"}},
+		{"SPLIT", []Expression{NewLiteralString("héllo"), NewLiteralString("")}, []interface{}{"h", "é", "l", "l", "o"}},
+		{"SPLIT", []Expression{NewLiteralString("abc"), NewLiteralString("x")}, []interface{}{"abc"}},
+		{"SPLIT", []Expression{NewProperty("doc.abv")}, nil},
+		{"REGEXP_MATCH", []Expression{NewProperty("doc.name"), NewLiteralString("M.*w")}, true},
+		{"REGEXP_MATCH", []Expression{NewProperty("doc.name"), NewLiteralString("View")}, false},
+		{"REGEXP_MATCH", []Expression{NewProperty("doc.name"), NewLiteralString("a|Mountain View")}, true},
+		{"REGEXP_MATCH", []Expression{NewProperty("doc.name"), NewLiteralString("(")}, nil},
+
+		{"OBJECT_KEYS", []Expression{NewProperty("doc")}, []interface{}{"abv", "empty", "name", "tags"}},
+		{"OBJECT_KEYS", []Expression{NewLiteralObject(map[string]Expression{})}, []interface{}{}},
+		{"OBJECT_KEYS", []Expression{NewProperty("doc.tags")}, nil},
+		{"OBJECT_KEYS", []Expression{NewProperty("doc.missing")}, nil},
+		{"OBJECT_VALUES", []Expression{NewProperty("meta")}, []interface{}{"first"}},
+		{"OBJECT_VALUES", []Expression{NewLiteralObject(map[string]Expression{"b": NewLiteralNumber(2.0), "a": NewLiteralNull()})}, []interface{}{nil, 2.0}},
+		{"OBJECT_VALUES", []Expression{NewProperty("doc.name")}, nil},
+		{"OBJECT_PAIRS", []Expression{NewLiteralObject(map[string]Expression{"b": NewLiteralNumber(2.0), "a": NewLiteralString("x")})}, []interface{}{
+			map[string]interface{}{"name": "a", "value": "x"},
+			map[string]interface{}{"name": "b", "value": 2.0},
+		}},
+		{"OBJECT_PAIRS", []Expression{NewLiteralNull()}, nil},
+
+		{"META", []Expression{}, row["meta"]},
+		{"VALUE", []Expression{}, row["doc"]},
+		{"value", []Expression{}, row["doc"]},
+	}
+
+	for _, x := range tests {
+		function, err := NewFunctionCall(x.name, x.operands)
+		if err != nil {
+			t.Fatalf("Error creating %v: %v", x.name, err)
+		}
+		result, err := function.Evaluate(NewContext(row))
+		if err != nil {
+			t.Fatalf("Error evaluating %v: %v", function, err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, function, result)
+		}
+	}
+
+}
+
+func TestFunctionsAsConditions(t *testing.T) {
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"name": "Pale Ale",
+		},
+	}
+
+	tests := []struct {
+		input  BooleanExpression
+		output bool
+	}{
+		{mustNewFunctionCall("CONTAINS", []Expression{NewProperty("doc.name"), NewLiteralString("Ale")}), true},
+		{mustNewFunctionCall("STARTS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("Ale")}), false},
+		{mustNewFunctionCall("UPPER", []Expression{NewProperty("doc.name")}), true},
+		{mustNewFunctionCall("CONTAINS", []Expression{NewProperty("doc.missing"), NewLiteralString("Ale")}), false},
+		{NewNotOperator(mustNewFunctionCall("STARTS_WITH", []Expression{NewProperty("doc.name"), NewLiteralString("Ale")})).NegationNormalForm(), true},
+	}
+
+	for _, x := range tests {
+		result, err := x.input.EvaluateBoolean(NewContext(row))
+		if err != nil {
+			t.Fatalf("Error evaluating %v: %v", x.input, err)
+		}
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestInvalidFunctions(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		operands []Expression
+	}{
+		{"NOSUCHFUNCTION", []Expression{}},
+		{"CEIL", []Expression{}},
+		{"CEIL", []Expression{NewLiteralNumber(1.0), NewLiteralNumber(2.0)}},
+		{"META", []Expression{NewLiteralNumber(1.0)}},
+		{"SUBSTR", []Expression{NewLiteralString("a")}},
+		{"SUM", []Expression{NewLiteralNumber(1.0), NewLiteralNumber(2.0)}},
+	}
+
+	for _, x := range tests {
+		_, err := NewFunctionCall(x.name, x.operands)
+		if err == nil {
+			t.Errorf("Expected error creating %v with %d arguments", x.name, len(x.operands))
+		}
+	}
+
+}
+
+func TestFunctionReferencedProperties(t *testing.T) {
+
+	tests := []struct {
+		input  Expression
+		output []Property
+	}{
+		{mustNewFunctionCall("UPPER", []Expression{NewProperty("doc.name")}), []Property{*NewProperty("doc.name")}},
+		{mustNewFunctionCall("IFNULL", []Expression{NewProperty("doc.abv"), NewProperty("doc.ibu")}), []Property{*NewProperty("doc.abv"), *NewProperty("doc.ibu")}},
+		{mustNewFunctionCall("META", []Expression{}), []Property{*NewProperty("meta")}},
+		{NewFieldOperator(mustNewFunctionCall("META", []Expression{}), "id"), []Property{*NewProperty("meta")}},
+		{mustNewFunctionCall("VALUE", []Expression{}), []Property{*NewProperty("doc")}},
+	}
+
+	for _, x := range tests {
+		result := x.input.ReferencedProperties()
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestRegexpCompiledOnce(t *testing.T) {
+
+	first := compileRegexp("M.*w")
+	if first == nil {
+		t.Fatalf("Expected M.*w to compile")
+	}
+	if second := compileRegexp("M.*w"); second != first {
+		t.Errorf("Expected the compiled pattern to be reused")
+	}
+	if invalid := compileRegexp("("); invalid != nil {
+		t.Errorf("Expected no pattern for (, got %v", invalid)
+	}
+
+}
@@ -0,0 +1,166 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// left IN right is TRUE when the array right has an element equal to left
+type InOperator struct {
+	BinaryOperator
+}
+
+func NewInOperator(left, right Expression) *InOperator {
+	return &InOperator{
+		BinaryOperator{
+			left:  left,
+			right: right,
+		},
+	}
+}
+
+func (this *InOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// MISSING if either operand is MISSING, otherwise NULL if either
+// is NULL, and FALSE if right is not an array
+func (this *InOperator) Evaluate(context Context) (interface{}, error) {
+	lv, err := this.left.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := this.right.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+	if lv == nil || rv == nil {
+		return nil, nil
+	}
+
+	switch rv := rv.(type) {
+	case []interface{}:
+		for _, element := range rv {
+			if argumentsEqual(lv, element) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (this *InOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *InOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *InOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *InOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *InOperator) String() string {
+	return fmt.Sprintf("%v IN %v", this.left, this.right)
+}
+
+// a property IN an array of literals is sargable
+func (this *InOperator) IsSargable() bool {
+	switch this.left.(type) {
+	case *Property:
+		switch right := this.right.(type) {
+		case *LiteralArray:
+			for _, element := range right.Value {
+				switch element.(type) {
+				case *LiteralBool, *LiteralNumber, *LiteralString:
+				default:
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (this *InOperator) GetSargProperty() *Property {
+	switch left := this.left.(type) {
+	case *Property:
+		return left
+	}
+	return nil
+}
+
+// the sarg value is the array of values
+func (this *InOperator) GetSargValue() (interface{}, error) {
+	return this.right.Evaluate(nil)
+}
+
+func (this *InOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	// logic to improve this with pathStats
+	if this.IsSargable() {
+		path := this.GetSargProperty().Path
+		pathStat, ok := pathStats[path]
+		if ok {
+			sargval, err := this.GetSargValue()
+			if err == nil {
+				rowsEstimate := 0.0
+				for _, value := range distinctValues(sargval.([]interface{})) {
+					rowsWithValue := RowsWithValue(pathStat, value)
+					if rowsWithValue == -1 {
+						rowsEstimate = -1
+						break
+					}
+					rowsEstimate = rowsEstimate + rowsWithValue
+				}
+				if rowsEstimate != -1 {
+					return float64(rowsEstimate / float64(pathStat.Rows))
+				}
+			}
+		}
+	}
+
+	// otherwise each value is as selective as = and they are combined like OR
+	switch right := this.right.(type) {
+	case *LiteralArray:
+		return 1.0 - math.Pow(1.0-1.0/10.0, float64(len(right.Value)))
+	}
+	return 1.0 / 3.0
+}
+
+func distinctValues(values []interface{}) []interface{} {
+	rv := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		found := false
+		for _, other := range rv {
+			if argumentsEqual(value, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rv = append(rv, value)
+		}
+	}
+	return rv
+}
@@ -0,0 +1,131 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+func TestIn(t *testing.T) {
+
+	row := map[string]interface{}{
+		"color":  "red",
+		"abv":    5.0,
+		"colors": []interface{}{"blue", "red"},
+		"null":   nil,
+	}
+
+	colors := NewLiteralArray([]Expression{NewLiteralString("red"), NewLiteralString("blue")})
+
+	tests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{NewInOperator(NewProperty("color"), colors), true},
+		{NewInOperator(NewLiteralString("green"), colors), false},
+		{NewInOperator(NewProperty("color"), NewProperty("colors")), true},
+		{NewInOperator(NewProperty("abv"), NewLiteralArray([]Expression{NewLiteralString("5"), NewLiteralNumber(5.0)})), true},
+		{NewInOperator(NewProperty("abv"), NewLiteralArray([]Expression{NewLiteralString("5")})), false},
+		{NewInOperator(NewProperty("abv"), NewLiteralArray([]Expression{})), false},
+		{NewInOperator(NewProperty("null"), NewLiteralArray([]Expression{NewLiteralNull()})), nil},
+		{NewInOperator(NewProperty("color"), NewLiteralArray([]Expression{NewLiteralNull()})), false},
+		{NewInOperator(NewProperty("color"), NewLiteralString("red")), false},
+		{NewInOperator(NewProperty("color"), NewProperty("null")), nil},
+		{NewInOperator(NewProperty("missing"), colors), MISSING},
+		{NewInOperator(NewProperty("null"), NewProperty("missing")), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestInSargable(t *testing.T) {
+
+	colors := NewLiteralArray([]Expression{NewLiteralString("red"), NewLiteralString("blue")})
+
+	tests := []struct {
+		input    *InOperator
+		sargable bool
+		sargval  interface{}
+	}{
+		{NewInOperator(NewProperty("doc.color"), colors), true, []interface{}{"red", "blue"}},
+		{NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{})), true, []interface{}{}},
+		{NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{NewProperty("doc.other")})), false, nil},
+		{NewInOperator(NewProperty("doc.color"), NewProperty("doc.colors")), false, nil},
+		{NewInOperator(NewLiteralString("red"), colors), false, nil},
+	}
+
+	for _, x := range tests {
+		if x.input.IsSargable() != x.sargable {
+			t.Errorf("Expected sargable %v for %v", x.sargable, x.input)
+		}
+		if x.sargable {
+			sargval, err := x.input.GetSargValue()
+			if err != nil {
+				t.Fatalf("Error getting sarg value: %v", err)
+			}
+			if !reflect.DeepEqual(sargval, x.sargval) {
+				t.Errorf("Expected sarg value %v for %v, got %v", x.sargval, x.input, sargval)
+			}
+		}
+	}
+
+}
+
+func TestInSelectivity(t *testing.T) {
+
+	colorStats := stats.DefaultPathStats("a", "z")
+	colorStats.Rows = 50
+	colorStats.MostFrequentValues.Consider("red", 6)
+	colorStats.MostFrequentValues.Consider("blue", 4)
+
+	pathStatistics := map[string]stats.PathStatistics{
+		"doc.color": colorStats,
+	}
+
+	red := NewLiteralString("red")
+	blue := NewLiteralString("blue")
+	green := NewLiteralString("green")
+
+	tests := []struct {
+		input  BooleanExpression
+		output float64
+	}{
+		{NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{red, blue})), 10.0 / 50.0},
+		// each value is only counted once
+		{NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{red, red})), 6.0 / 50.0},
+		// without an estimate for every value fall back to defaults
+		{NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{red, green})), 1.0 - math.Pow(9.0/10.0, 2)},
+		{NewInOperator(NewProperty("doc.other"), NewLiteralArray([]Expression{red})), 1.0 - math.Pow(9.0/10.0, 1)},
+		{NewInOperator(NewProperty("doc.color"), NewProperty("doc.colors")), 1.0 / 3.0},
+	}
+
+	for _, x := range tests {
+		result := x.input.GetSelectivity(pathStatistics)
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
@@ -0,0 +1,295 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// the IS operators test the type of a value rather than comparing it
+// unlike the comparison operators they are always TRUE or FALSE
+// IS VALUED is a synonym for IS NOT NULL and IS NOT VALUED for IS NULL
+type UnaryOperator struct {
+	operand Expression
+}
+
+func (this *UnaryOperator) evaluateOperand(context Context) (interface{}, error) {
+	return this.operand.Evaluate(context)
+}
+
+func (this *UnaryOperator) ReferencedProperties() []Property {
+	return this.operand.ReferencedProperties()
+}
+
+func (this *UnaryOperator) ReferencedAggregates() []AggregateFunction {
+	return this.operand.ReferencedAggregates()
+}
+
+func (this *UnaryOperator) IsSargable() bool {
+	switch this.operand.(type) {
+	case *Property:
+		return true
+	}
+	return false
+}
+
+func (this *UnaryOperator) GetSargProperty() *Property {
+	switch operand := this.operand.(type) {
+	case *Property:
+		return operand
+	}
+	return nil
+}
+
+// the index key being tested for is always null
+func (this *UnaryOperator) GetSargValue() (interface{}, error) {
+	return nil, nil
+}
+
+// the path statistics of the operand, if it is a property
+func (this *UnaryOperator) pathStatistics(pathStats map[string]stats.PathStatistics) (stats.PathStatistics, bool) {
+	property := this.GetSargProperty()
+	if property == nil {
+		return stats.PathStatistics{}, false
+	}
+	pathStat, ok := pathStats[property.Path]
+	return pathStat, ok
+}
+
+type IsNullOperator struct {
+	UnaryOperator
+}
+
+func NewIsNullOperator(operand Expression) *IsNullOperator {
+	return &IsNullOperator{
+		UnaryOperator{
+			operand: operand,
+		},
+	}
+}
+
+func (this *IsNullOperator) EvaluateBoolean(context Context) (bool, error) {
+	value, err := this.evaluateOperand(context)
+	if err != nil {
+		return false, err
+	}
+	return value == nil, nil
+}
+
+func (this *IsNullOperator) Evaluate(context Context) (interface{}, error) {
+	return this.EvaluateBoolean(context)
+}
+
+func (this *IsNullOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsNullOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+// a value that is not NULL is either some other value or MISSING
+func (this *IsNullOperator) DistributeNot() BooleanExpression {
+	return NewOrOperator([]BooleanExpression{NewIsNotNullOperator(this.operand), NewIsMissingOperator(this.operand)})
+}
+
+func (this *IsNullOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *IsNullOperator) String() string {
+	return fmt.Sprintf("%v IS NULL", this.operand)
+}
+
+func (this *IsNullOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	rv := 1.0 / 10.0
+
+	pathStat, ok := this.pathStatistics(pathStats)
+	if ok {
+		rowsEstimate := RowsWithValue(pathStat, nil)
+		if rowsEstimate != -1 {
+			return float64(rowsEstimate / float64(pathStat.Rows))
+		}
+	}
+
+	return rv
+}
+
+type IsNotNullOperator struct {
+	UnaryOperator
+}
+
+func NewIsNotNullOperator(operand Expression) *IsNotNullOperator {
+	return &IsNotNullOperator{
+		UnaryOperator{
+			operand: operand,
+		},
+	}
+}
+
+func (this *IsNotNullOperator) EvaluateBoolean(context Context) (bool, error) {
+	value, err := this.evaluateOperand(context)
+	if err != nil {
+		return false, err
+	}
+	return value != nil && value != MISSING, nil
+}
+
+func (this *IsNotNullOperator) Evaluate(context Context) (interface{}, error) {
+	return this.EvaluateBoolean(context)
+}
+
+func (this *IsNotNullOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsNotNullOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+// a value that is not valued is either NULL or MISSING
+func (this *IsNotNullOperator) DistributeNot() BooleanExpression {
+	return NewOrOperator([]BooleanExpression{NewIsNullOperator(this.operand), NewIsMissingOperator(this.operand)})
+}
+
+func (this *IsNotNullOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *IsNotNullOperator) String() string {
+	return fmt.Sprintf("%v IS NOT NULL", this.operand)
+}
+
+func (this *IsNotNullOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	rv := 9.0 / 10.0
+
+	pathStat, ok := this.pathStatistics(pathStats)
+	if ok {
+		rowsEstimate := RowsWithoutValue(pathStat, nil)
+		if rowsEstimate != -1 {
+			return float64(rowsEstimate / float64(pathStat.Rows))
+		}
+	}
+
+	return rv
+}
+
+type IsMissingOperator struct {
+	UnaryOperator
+}
+
+func NewIsMissingOperator(operand Expression) *IsMissingOperator {
+	return &IsMissingOperator{
+		UnaryOperator{
+			operand: operand,
+		},
+	}
+}
+
+func (this *IsMissingOperator) EvaluateBoolean(context Context) (bool, error) {
+	value, err := this.evaluateOperand(context)
+	if err != nil {
+		return false, err
+	}
+	return value == MISSING, nil
+}
+
+func (this *IsMissingOperator) Evaluate(context Context) (interface{}, error) {
+	return this.EvaluateBoolean(context)
+}
+
+func (this *IsMissingOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsMissingOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsMissingOperator) DistributeNot() BooleanExpression {
+	return NewIsNotMissingOperator(this.operand)
+}
+
+func (this *IsMissingOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+// documents without the property are not in an index on it
+// so this can never be satisfied with an index scan
+func (this *IsMissingOperator) IsSargable() bool {
+	return false
+}
+
+func (this *IsMissingOperator) String() string {
+	return fmt.Sprintf("%v IS MISSING", this.operand)
+}
+
+func (this *IsMissingOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	// the path statistics only describe documents with the property
+	return 1.0 / 10.0
+}
+
+type IsNotMissingOperator struct {
+	UnaryOperator
+}
+
+func NewIsNotMissingOperator(operand Expression) *IsNotMissingOperator {
+	return &IsNotMissingOperator{
+		UnaryOperator{
+			operand: operand,
+		},
+	}
+}
+
+func (this *IsNotMissingOperator) EvaluateBoolean(context Context) (bool, error) {
+	value, err := this.evaluateOperand(context)
+	if err != nil {
+		return false, err
+	}
+	return value != MISSING, nil
+}
+
+func (this *IsNotMissingOperator) Evaluate(context Context) (interface{}, error) {
+	return this.EvaluateBoolean(context)
+}
+
+func (this *IsNotMissingOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsNotMissingOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *IsNotMissingOperator) DistributeNot() BooleanExpression {
+	return NewIsMissingOperator(this.operand)
+}
+
+func (this *IsNotMissingOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *IsNotMissingOperator) String() string {
+	return fmt.Sprintf("%v IS NOT MISSING", this.operand)
+}
+
+func (this *IsNotMissingOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	rv := 9.0 / 10.0
+
+	// every row described by the path statistics has the property
+	_, ok := this.pathStatistics(pathStats)
+	if ok {
+		return 1.0
+	}
+
+	return rv
+}
@@ -0,0 +1,128 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+func TestIsOperators(t *testing.T) {
+
+	row := map[string]interface{}{
+		"value": "couchbase",
+		"null":  nil,
+	}
+
+	value := NewProperty("value")
+	null := NewProperty("null")
+	missing := NewProperty("missing")
+
+	tests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{NewIsNullOperator(value), false},
+		{NewIsNullOperator(null), true},
+		{NewIsNullOperator(missing), false},
+
+		{NewIsNotNullOperator(value), true},
+		{NewIsNotNullOperator(null), false},
+		{NewIsNotNullOperator(missing), false},
+
+		{NewIsMissingOperator(value), false},
+		{NewIsMissingOperator(null), false},
+		{NewIsMissingOperator(missing), true},
+
+		{NewIsNotMissingOperator(value), true},
+		{NewIsNotMissingOperator(null), true},
+		{NewIsNotMissingOperator(missing), false},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+
+		// NOT distributed through the operator must be its negation
+		negated, err := NewNotOperator(x.input).NegationNormalForm().Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(negated, !x.output.(bool)) {
+			t.Errorf("Expected %v for NOT %v, got %v", !x.output.(bool), x.input, negated)
+		}
+	}
+
+}
+
+func TestIsOperatorsSargable(t *testing.T) {
+
+	tests := []struct {
+		input    BooleanExpression
+		sargable bool
+	}{
+		{NewIsNullOperator(NewProperty("doc.abv")), true},
+		{NewIsNotNullOperator(NewProperty("doc.abv")), true},
+		{NewIsMissingOperator(NewProperty("doc.abv")), false},
+		{NewIsNotMissingOperator(NewProperty("doc.abv")), true},
+		{NewIsNotMissingOperator(NewLiteralNull()), false},
+	}
+
+	for _, x := range tests {
+		if x.input.IsSargable() != x.sargable {
+			t.Errorf("Expected sargable %v for %v", x.sargable, x.input)
+		}
+	}
+
+}
+
+func TestIsOperatorsSelectivity(t *testing.T) {
+
+	abvStats := stats.DefaultPathStats(nil, 100.0)
+	abvStats.Rows = 50
+	abvStats.DistinctValues = 10
+	abvStats.MostFrequentValues.Consider(nil, 5)
+
+	pathStatistics := map[string]stats.PathStatistics{
+		"doc.abv": abvStats,
+	}
+
+	tests := []struct {
+		input   BooleanExpression
+		noStats float64
+		output  float64
+	}{
+		{NewIsNullOperator(NewProperty("doc.abv")), 1.0 / 10.0, 5.0 / 50.0},
+		{NewIsNotNullOperator(NewProperty("doc.abv")), 9.0 / 10.0, 45.0 / 50.0},
+		{NewIsMissingOperator(NewProperty("doc.abv")), 1.0 / 10.0, 1.0 / 10.0},
+		{NewIsNotMissingOperator(NewProperty("doc.abv")), 9.0 / 10.0, 1.0},
+	}
+
+	for _, x := range tests {
+		result := x.input.GetSelectivity(map[string]stats.PathStatistics{})
+		if result != x.noStats {
+			t.Errorf("Expected %v without stats for %v, got %v", x.noStats, x.input, result)
+		}
+		result = x.input.GetSelectivity(pathStatistics)
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
@@ -0,0 +1,194 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// in a LIKE pattern % (or *) matches any string of zero or more
+// characters and _ (or ?) matches any single character
+const LIKE_WILDCARDS = "%*_?"
+
+type LikeOperator struct {
+	BinaryOperator
+	// compiled once when the pattern is a literal
+	regexp *regexp.Regexp
+}
+
+func NewLikeOperator(left, right Expression) *LikeOperator {
+	rv := &LikeOperator{
+		BinaryOperator: BinaryOperator{
+			left:  left,
+			right: right,
+		},
+	}
+	switch right := right.(type) {
+	case *LiteralString:
+		re, err := likePatternToRegexp(right.Value)
+		if err == nil {
+			rv.regexp = re
+		}
+	}
+	return rv
+}
+
+func (this *LikeOperator) EvaluateBoolean(context Context) (bool, error) {
+	return evaluateBoolean(this, context)
+}
+
+// MISSING if either operand is MISSING, otherwise NULL if either
+// is NULL, and FALSE if either is not a string
+func (this *LikeOperator) Evaluate(context Context) (interface{}, error) {
+	lv, err := this.left.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := this.right.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if lv == MISSING || rv == MISSING {
+		return MISSING, nil
+	}
+	if lv == nil || rv == nil {
+		return nil, nil
+	}
+
+	value, ok := lv.(string)
+	if !ok {
+		return false, nil
+	}
+	pattern, ok := rv.(string)
+	if !ok {
+		return false, nil
+	}
+
+	re := this.regexp
+	if re == nil {
+		re, err = likePatternToRegexp(pattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return re.MatchString(value), nil
+}
+
+func likePatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	expr := "(?s)^"
+	literal := ""
+	for _, c := range pattern {
+		switch c {
+		case '%', '*':
+			expr = expr + regexp.QuoteMeta(literal) + ".*"
+			literal = ""
+		case '_', '?':
+			expr = expr + regexp.QuoteMeta(literal) + "."
+			literal = ""
+		default:
+			literal = literal + string(c)
+		}
+	}
+	expr = expr + regexp.QuoteMeta(literal) + "$"
+	return regexp.Compile(expr)
+}
+
+// the part of the pattern before the first wildcard
+func LikePrefix(pattern string) string {
+	wildcard := strings.IndexAny(pattern, LIKE_WILDCARDS)
+	if wildcard < 0 {
+		return pattern
+	}
+	return pattern[0:wildcard]
+}
+
+func (this *LikeOperator) ConjunctiveNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *LikeOperator) NegationNormalForm() BooleanExpression {
+	return this
+}
+
+func (this *LikeOperator) DistributeNot() BooleanExpression {
+	return NewNotOperator(this)
+}
+
+func (this *LikeOperator) ConvertToBooleanFactors() []BooleanExpression {
+	return []BooleanExpression{this}
+}
+
+func (this *LikeOperator) String() string {
+	return fmt.Sprintf("%v LIKE %v", this.left, this.right)
+}
+
+// only patterns with a literal prefix can narrow an index scan
+func (this *LikeOperator) IsSargable() bool {
+	switch this.left.(type) {
+	case *Property:
+		switch right := this.right.(type) {
+		case *LiteralString:
+			return LikePrefix(right.Value) != ""
+		}
+	}
+	return false
+}
+
+func (this *LikeOperator) GetSargProperty() *Property {
+	switch left := this.left.(type) {
+	case *Property:
+		return left
+	}
+	return nil
+}
+
+// the sarg value is the literal prefix of the pattern
+func (this *LikeOperator) GetSargValue() (interface{}, error) {
+	switch right := this.right.(type) {
+	case *LiteralString:
+		return LikePrefix(right.Value), nil
+	}
+	return nil, nil
+}
+
+func (this *LikeOperator) GetSelectivity(pathStats map[string]stats.PathStatistics) float64 {
+	rv := 1.0 / 5.0
+
+	// logic to improve this with pathStats
+	if this.IsSargable() {
+		path := this.GetSargProperty().Path
+		pathStat, ok := pathStats[path]
+		if ok {
+			sargval, err := this.GetSargValue()
+			if err == nil {
+				prefix := sargval.(string)
+				rowsEstimate := RowsBetweenValues(pathStat, prefix, LikePrefixUpperBound(prefix))
+				if rowsEstimate >= 0 {
+					return float64(rowsEstimate / float64(pathStat.Rows))
+				}
+			}
+		}
+	}
+
+	return rv
+}
+
+// a string that collates after every string beginning with prefix
+// U+FFFF is a noncharacter which ICU does not sort after everything,
+// U+EFFF is the bound Couchbase documents for view key prefixes, only
+// the private use characters after it are not covered
+func LikePrefixUpperBound(prefix string) string {
+	return prefix + "\uefff"
+}
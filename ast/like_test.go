@@ -0,0 +1,135 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+func TestLike(t *testing.T) {
+
+	row := map[string]interface{}{
+		"name":    "Budweiser",
+		"pattern": "%wei%",
+		"abv":     5.0,
+		"null":    nil,
+	}
+
+	name := NewProperty("name")
+
+	tests := []struct {
+		input  BooleanExpression
+		output interface{}
+	}{
+		{NewLikeOperator(name, NewLiteralString("Bud*")), true},
+		{NewLikeOperator(name, NewLiteralString("Bud%")), true},
+		{NewLikeOperator(name, NewLiteralString("bud*")), false},
+		{NewLikeOperator(name, NewLiteralString("Budweiser")), true},
+		{NewLikeOperator(name, NewLiteralString("Bud")), false},
+		{NewLikeOperator(name, NewLiteralString("*weiser")), true},
+		{NewLikeOperator(name, NewLiteralString("Bud?eiser")), true},
+		{NewLikeOperator(name, NewLiteralString("Bud_eiser")), true},
+		{NewLikeOperator(name, NewLiteralString("Bud?")), false},
+		{NewLikeOperator(name, NewLiteralString("B*w?i*r")), true},
+		{NewLikeOperator(name, NewProperty("pattern")), true},
+
+		// regular expression characters match themselves
+		{NewLikeOperator(NewLiteralString("a.c"), NewLiteralString("a.c")), true},
+		{NewLikeOperator(NewLiteralString("abc"), NewLiteralString("a.c")), false},
+		{NewLikeOperator(NewLiteralString("(a)+[b]"), NewLiteralString("(a)+[*")), true},
+		{NewLikeOperator(NewLiteralString("line\nbreak"), NewLiteralString("line%")), true},
+
+		{NewLikeOperator(NewProperty("abv"), NewLiteralString("5*")), false},
+		{NewLikeOperator(name, NewLiteralNumber(5.0)), false},
+		{NewLikeOperator(NewProperty("null"), NewLiteralString("Bud*")), nil},
+		{NewLikeOperator(name, NewLiteralNull()), nil},
+		{NewLikeOperator(NewProperty("missing"), NewLiteralString("Bud*")), MISSING},
+		{NewLikeOperator(NewProperty("null"), NewProperty("missing")), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestLikeSargable(t *testing.T) {
+
+	tests := []struct {
+		input    *LikeOperator
+		sargable bool
+		sargval  interface{}
+	}{
+		{NewLikeOperator(NewProperty("doc.name"), NewLiteralString("Bud*")), true, "Bud"},
+		{NewLikeOperator(NewProperty("doc.name"), NewLiteralString("Bud_ight")), true, "Bud"},
+		{NewLikeOperator(NewProperty("doc.name"), NewLiteralString("Budweiser")), true, "Budweiser"},
+		{NewLikeOperator(NewProperty("doc.name"), NewLiteralString("*weiser")), false, nil},
+		{NewLikeOperator(NewProperty("doc.name"), NewProperty("doc.pattern")), false, nil},
+		{NewLikeOperator(NewLiteralString("Budweiser"), NewLiteralString("Bud*")), false, nil},
+	}
+
+	for _, x := range tests {
+		if x.input.IsSargable() != x.sargable {
+			t.Errorf("Expected sargable %v for %v", x.sargable, x.input)
+		}
+		if x.sargable {
+			sargval, err := x.input.GetSargValue()
+			if err != nil {
+				t.Fatalf("Error getting sarg value: %v", err)
+			}
+			if sargval != x.sargval {
+				t.Errorf("Expected sarg value %v for %v, got %v", x.sargval, x.input, sargval)
+			}
+		}
+	}
+
+	// every string with the prefix must collate inside the range
+	prefix := "Bud"
+	upper := LikePrefixUpperBound(prefix)
+	// including characters from the top of the BMP and supplementary planes
+	for _, value := range []string{"Bud", "Budweiser", "Bud Light", "Budé", "Bud\ufffd", "Bud\u9fff", "Bud\ue000", "Bud\U0001f37a", "Bud\U00020000", "Bud\U0001d11e"} {
+		if CollateJSON(value, prefix) < 0 || CollateJSON(value, upper) > 0 {
+			t.Errorf("Expected %v to collate between %v and %v", value, prefix, upper)
+		}
+	}
+	for _, value := range []string{"Bua", "Bue", "Coors"} {
+		if CollateJSON(value, prefix) >= 0 && CollateJSON(value, upper) <= 0 {
+			t.Errorf("Expected %v to collate outside %v and %v", value, prefix, upper)
+		}
+	}
+
+}
+
+func TestLikeSelectivity(t *testing.T) {
+
+	like := NewLikeOperator(NewProperty("doc.name"), NewLiteralString("*weiser"))
+	result := like.GetSelectivity(map[string]stats.PathStatistics{})
+	if result != 1.0/5.0 {
+		t.Errorf("Expected %v for %v, got %v", 1.0/5.0, like, result)
+	}
+
+	like = NewLikeOperator(NewProperty("doc.name"), NewLiteralString("Bud*"))
+	result = like.GetSelectivity(map[string]stats.PathStatistics{})
+	if result != 1.0/5.0 {
+		t.Errorf("Expected %v without stats for %v, got %v", 1.0/5.0, like, result)
+	}
+
+}
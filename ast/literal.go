@@ -34,6 +34,10 @@ func (this *LiteralNull) ReferencedProperties() []Property {
 	return []Property{}
 }
 
+func (this *LiteralNull) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{}
+}
+
 type LiteralBool struct {
 	Value bool
 }
@@ -76,6 +80,10 @@ func (this *LiteralBool) ReferencedProperties() []Property {
 	return []Property{}
 }
 
+func (this *LiteralBool) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{}
+}
+
 func (this *LiteralBool) IsSargable() bool {
 	return false
 }
@@ -117,6 +125,10 @@ func (this *LiteralNumber) ReferencedProperties() []Property {
 	return []Property{}
 }
 
+func (this *LiteralNumber) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{}
+}
+
 type LiteralString struct {
 	Value string
 }
@@ -139,6 +151,10 @@ func (this *LiteralString) ReferencedProperties() []Property {
 	return []Property{}
 }
 
+func (this *LiteralString) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{}
+}
+
 type LiteralArray struct {
 	Value []Expression
 }
@@ -156,6 +172,10 @@ func (this *LiteralArray) Evaluate(context Context) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		if ev == MISSING {
+			// arrays cannot contain MISSING
+			ev = nil
+		}
 		rv = append(rv, ev)
 	}
 	return rv, nil
@@ -176,6 +196,17 @@ func (this *LiteralArray) ReferencedProperties() []Property {
 	return rv
 }
 
+func (this *LiteralArray) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+	for _, v := range this.Value {
+		ra := v.ReferencedAggregates()
+		for _, rav := range ra {
+			rv = append(rv, rav)
+		}
+	}
+	return rv
+}
+
 type LiteralObject struct {
 	Value map[string]Expression
 }
@@ -193,7 +224,9 @@ func (this *LiteralObject) Evaluate(context Context) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		rv[k] = ev
+		if ev != MISSING {
+			rv[k] = ev
+		}
 	}
 	return rv, nil
 }
@@ -212,3 +245,14 @@ func (this *LiteralObject) ReferencedProperties() []Property {
 	}
 	return rv
 }
+
+func (this *LiteralObject) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+	for _, v := range this.Value {
+		ra := v.ReferencedAggregates()
+		for _, rav := range ra {
+			rv = append(rv, rav)
+		}
+	}
+	return rv
+}
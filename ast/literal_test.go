@@ -31,10 +31,12 @@ func TestEvaluateLiteral(t *testing.T) {
 		{NewLiteralArray([]Expression{NewLiteralNumber(1.0), NewLiteralBool(false), NewLiteralString("bob")}), []interface{}{1.0, false, "bob"}},
 		{NewLiteralObject(map[string]Expression{"name": NewLiteralString("bob")}), map[string]interface{}{"name": "bob"}},
 		{NewLiteralObject(map[string]Expression{"user": NewLiteralString("test"), "age": NewLiteralNumber(27.0)}), map[string]interface{}{"age": 27.0, "user": "test"}},
+		{NewLiteralArray([]Expression{NewLiteralNumber(1.0), NewProperty("dne")}), []interface{}{1.0, nil}},
+		{NewLiteralObject(map[string]Expression{"user": NewLiteralString("test"), "age": NewProperty("dne")}), map[string]interface{}{"user": "test"}},
 	}
 
 	for _, x := range tests {
-		result, err := x.input.Evaluate(nil)
+		result, err := x.input.Evaluate(NewContext(map[string]interface{}{}))
 		if err != nil {
 			t.Fatalf("Error evaluating expression: %v", err)
 		}
@@ -0,0 +1,56 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"math"
+)
+
+// MissingValue is the type of MISSING, the value of a property
+// that does not exist (as opposed to one explicitly set to null,
+// which is represented by nil)
+type MissingValue struct{}
+
+var MISSING = MissingValue{}
+
+func (this MissingValue) String() string {
+	return "MISSING"
+}
+
+// MISSING should never be part of a result, but if it is
+// it has no better JSON representation than null
+func (this MissingValue) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// the value of an expression in a boolean context
+// NULL and MISSING are preserved, other values are
+// converted using the same rules as JavaScript
+func truthValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case nil, bool, MissingValue:
+		return value
+	case float64:
+		return value != 0 && !math.IsNaN(value)
+	case string:
+		return value != ""
+	}
+	// arrays and objects
+	return true
+}
+
+// only a value of TRUE passes a WHERE or HAVING clause
+func evaluateBoolean(expression Expression, context Context) (bool, error) {
+	value, err := expression.Evaluate(context)
+	if err != nil {
+		return false, err
+	}
+	return truthValue(value) == true, nil
+}
@@ -38,3 +38,7 @@ func (this *Property) String() string {
 func (this *Property) ReferencedProperties() []Property {
 	return []Property{*this}
 }
+
+func (this *Property) ReferencedAggregates() []AggregateFunction {
+	return []AggregateFunction{}
+}
@@ -0,0 +1,149 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResultExpression is one member of the result expression list
+// either * (the whole input object) or an expression with a name
+type ResultExpression struct {
+	Star bool
+	Expr Expression
+	As   string
+}
+
+func NewStarResultExpression() *ResultExpression {
+	return &ResultExpression{
+		Star: true,
+	}
+}
+
+func NewResultExpression(expr Expression) *ResultExpression {
+	return &ResultExpression{
+		Expr: expr,
+	}
+}
+
+func NewResultExpressionWithAlias(expr Expression, as string) *ResultExpression {
+	return &ResultExpression{
+		Expr: expr,
+		As:   as,
+	}
+}
+
+func (this *ResultExpression) String() string {
+	if this.Star {
+		return "*"
+	}
+	if this.As != "" {
+		return fmt.Sprintf("%v AS %v", this.Expr, this.As)
+	}
+	return fmt.Sprintf("%v", this.Expr)
+}
+
+// ResultExpressionList evaluates to a single object per row
+// with one key for each named result expression
+type ResultExpressionList []*ResultExpression
+
+// expressions without an AS clause are named by the last element
+// of their path, any other expression is named by its position
+func (this ResultExpressionList) AssignDefaultNames() {
+	for i, resultExpr := range this {
+		if resultExpr.Star || resultExpr.As != "" {
+			continue
+		}
+		switch expr := resultExpr.Expr.(type) {
+		case *Property:
+			resultExpr.As = lastPathElement(expr.Path)
+		default:
+			resultExpr.As = fmt.Sprintf("$%d", i+1)
+		}
+	}
+}
+
+func (this ResultExpressionList) Evaluate(context Context) (interface{}, error) {
+	rv := make(map[string]interface{})
+	for _, resultExpr := range this {
+		if resultExpr.Star {
+			// start with the keys of the original item
+			item, err := context.GetPath("")
+			if err != nil {
+				return nil, err
+			}
+			switch item := item.(type) {
+			case map[string]interface{}:
+				for k, v := range item {
+					if k != AGGREGATES_KEY {
+						rv[k] = v
+					}
+				}
+			}
+			continue
+		}
+
+		value, err := resultExpr.Expr.Evaluate(context)
+		if err != nil {
+			return nil, err
+		}
+		rv[resultExpr.As] = value
+	}
+	return rv, nil
+}
+
+func (this ResultExpressionList) ContainsStar() bool {
+	for _, resultExpr := range this {
+		if resultExpr.Star {
+			return true
+		}
+	}
+	return false
+}
+
+func (this ResultExpressionList) String() string {
+	rv := make([]string, 0, len(this))
+	for _, resultExpr := range this {
+		rv = append(rv, resultExpr.String())
+	}
+	return strings.Join(rv, ", ")
+}
+
+func (this ResultExpressionList) ReferencedProperties() []Property {
+	rv := make([]Property, 0)
+	for _, resultExpr := range this {
+		if !resultExpr.Star {
+			rv = append(rv, resultExpr.Expr.ReferencedProperties()...)
+		}
+	}
+	return rv
+}
+
+func (this ResultExpressionList) ReferencedAggregates() []AggregateFunction {
+	rv := make([]AggregateFunction, 0)
+	for _, resultExpr := range this {
+		if !resultExpr.Star {
+			rv = append(rv, resultExpr.Expr.ReferencedAggregates()...)
+		}
+	}
+	return rv
+}
+
+func lastPathElement(path string) string {
+	elements := strings.Split(path, ".")
+	rv := elements[len(elements)-1]
+	// array indexes are not part of the name
+	lbIndex := strings.Index(rv, "[")
+	if lbIndex > 0 {
+		rv = rv[0:lbIndex]
+	}
+	return rv
+}
@@ -0,0 +1,110 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResultExpressionList(t *testing.T) {
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"name": "UNQL",
+			"address": map[string]interface{}{
+				"city": "Mountain View",
+			},
+			"revisions": []interface{}{2013.0},
+		},
+		"meta": map[string]interface{}{
+			"id": "unql-2013",
+		},
+		AGGREGATES_KEY: map[string]interface{}{},
+	}
+
+	tests := []struct {
+		input  ResultExpressionList
+		output map[string]interface{}
+	}{
+		{
+			ResultExpressionList{NewStarResultExpression()},
+			map[string]interface{}{
+				"doc":  row["doc"],
+				"meta": row["meta"],
+			},
+		},
+		{
+			ResultExpressionList{NewResultExpression(NewProperty("doc.name"))},
+			map[string]interface{}{
+				"name": "UNQL",
+			},
+		},
+		{
+			ResultExpressionList{
+				NewResultExpression(NewProperty("doc.address.city")),
+				NewResultExpression(NewProperty("doc.revisions[0]")),
+			},
+			map[string]interface{}{
+				"city":      "Mountain View",
+				"revisions": 2013.0,
+			},
+		},
+		{
+			ResultExpressionList{
+				NewResultExpression(NewSubtractOperator(NewProperty("doc.revisions[0]"), NewLiteralNumber(13.0))),
+			},
+			map[string]interface{}{
+				"$1": 2000.0,
+			},
+		},
+		{
+			ResultExpressionList{
+				NewResultExpressionWithAlias(NewSubtractOperator(NewProperty("doc.revisions[0]"), NewLiteralNumber(13.0)), "modified_revision"),
+			},
+			map[string]interface{}{
+				"modified_revision": 2000.0,
+			},
+		},
+		{
+			ResultExpressionList{
+				NewStarResultExpression(),
+				NewResultExpressionWithAlias(NewProperty("meta"), "custom_meta_field"),
+			},
+			map[string]interface{}{
+				"doc":               row["doc"],
+				"meta":              row["meta"],
+				"custom_meta_field": row["meta"],
+			},
+		},
+		{
+			ResultExpressionList{
+				NewResultExpressionWithAlias(NewLiteralObject(map[string]Expression{"thename": NewProperty("doc.name")}), "custom_obj"),
+			},
+			map[string]interface{}{
+				"custom_obj": map[string]interface{}{
+					"thename": "UNQL",
+				},
+			},
+		},
+	}
+
+	for _, x := range tests {
+		x.input.AssignDefaultNames()
+		result, err := x.input.Evaluate(NewContext(row))
+		if err != nil {
+			t.Fatalf("Error evaluating %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
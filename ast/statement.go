@@ -21,19 +21,29 @@ type Statement interface {
 	GetFrom() []DataSource
 	GetWhere() BooleanExpression
 	SetFrom([]DataSource)
-	GetSelect() Expression
+	GetSelect() ResultExpressionList
+	GetGroupBy() []Expression
+	GetHaving() BooleanExpression
+	GetAggregateReferences() []AggregateFunction
+	IsAggregate() bool
+	IsDistinct() bool
+	IsExplain() bool
 	GetOrder() []OrderedExpression
 	GetLimit() int
 	GetOffset() int
 }
 
 type SelectStatement struct {
-	From   []DataSource
-	Where  BooleanExpression
-	Select Expression
-	Order  []OrderedExpression
-	Limit  int
-	Offset int
+	Explain  bool
+	Distinct bool
+	From     []DataSource
+	Where    BooleanExpression
+	Select   ResultExpressionList
+	GroupBy  []Expression
+	Having   BooleanExpression
+	Order    []OrderedExpression
+	Limit    int
+	Offset   int
 }
 
 func (this *SelectStatement) GetType() string {
@@ -52,10 +62,60 @@ func (this *SelectStatement) SetFrom(from []DataSource) {
 	this.From = from
 }
 
-func (this *SelectStatement) GetSelect() Expression {
+func (this *SelectStatement) GetSelect() ResultExpressionList {
 	return this.Select
 }
 
+func (this *SelectStatement) GetGroupBy() []Expression {
+	return this.GroupBy
+}
+
+func (this *SelectStatement) GetHaving() BooleanExpression {
+	return this.Having
+}
+
+// returns the distinct aggregate functions referenced
+// in the SELECT, HAVING and ORDER BY clauses
+func (this *SelectStatement) GetAggregateReferences() []AggregateFunction {
+	rv := make([]AggregateFunction, 0, 0)
+	seen := make(map[string]bool)
+
+	expressions := make([]Expression, 0, len(this.Order)+2)
+	if len(this.Select) > 0 {
+		expressions = append(expressions, this.Select)
+	}
+	if this.Having != nil {
+		expressions = append(expressions, this.Having)
+	}
+	for _, oe := range this.Order {
+		expressions = append(expressions, oe.Expression())
+	}
+
+	for _, expr := range expressions {
+		for _, aggregate := range expr.ReferencedAggregates() {
+			if !seen[aggregate.Key()] {
+				seen[aggregate.Key()] = true
+				rv = append(rv, aggregate)
+			}
+		}
+	}
+	return rv
+}
+
+// a query is an aggregate query if it has a GROUP BY or HAVING
+// clause or references any aggregate functions
+func (this *SelectStatement) IsAggregate() bool {
+	return len(this.GroupBy) > 0 || this.Having != nil || len(this.GetAggregateReferences()) > 0
+}
+
+func (this *SelectStatement) IsDistinct() bool {
+	return this.Distinct
+}
+
+func (this *SelectStatement) IsExplain() bool {
+	return this.Explain
+}
+
 func (this *SelectStatement) GetOrder() []OrderedExpression {
 	return this.Order
 }
@@ -69,16 +129,26 @@ func (this *SelectStatement) GetOffset() int {
 }
 
 func (this *SelectStatement) String() string {
-	return fmt.Sprintf("SELECT %v WHERE %v ORDER BY %v LIMIT %v OFFSET %v", this.Select, this.Where, this.Order, this.Limit, this.Offset)
+	explain := ""
+	if this.Explain {
+		explain = "EXPLAIN "
+	}
+	distinct := ""
+	if this.Distinct {
+		distinct = "DISTINCT "
+	}
+	return fmt.Sprintf("%vSELECT %v%v FROM %v WHERE %v GROUP BY %v HAVING %v ORDER BY %v LIMIT %v OFFSET %v", explain, distinct, this.Select, this.From, this.Where, this.GroupBy, this.Having, this.Order, this.Limit, this.Offset)
 }
 
 func NewSelectStatement() *SelectStatement {
 	return &SelectStatement{
-		From:   make([]DataSource, 0),
-		Where:  NewLiteralBool(true),
-		Order:  make([]OrderedExpression, 0),
-		Limit:  -1,
-		Offset: 0,
+		From:    make([]DataSource, 0),
+		Where:   NewLiteralBool(true),
+		Select:  ResultExpressionList{NewStarResultExpression()},
+		GroupBy: make([]Expression, 0),
+		Order:   make([]OrderedExpression, 0),
+		Limit:   -1,
+		Offset:  0,
 	}
 }
 
@@ -93,7 +163,9 @@ func NewStatementFromJSONRequestToBucket(bucket string, body map[string]interfac
 		return nil, err
 	}
 
-	statement.SetFrom([]DataSource{NewNamedDataSource(bucket)})
+	// the bucket comes before any data sources joined by OVER
+	from := []DataSource{NewNamedDataSource(bucket)}
+	statement.SetFrom(append(from, statement.GetFrom()...))
 
 	return statement, nil
 }
@@ -112,6 +184,41 @@ func parseStatementJSON(statementJSON map[string]interface{}) (Statement, error)
 
 func parseSelectStatementJSON(statementJSON map[string]interface{}) (Statement, error) {
 	selectStatement := NewSelectStatement()
+	explainJSON, ok := statementJSON["explain"]
+	if ok {
+		switch explainJSON := explainJSON.(type) {
+		case bool:
+			selectStatement.Explain = explainJSON
+		default:
+			return nil, fmt.Errorf("explain must be a boolean")
+		}
+	}
+
+	distinctJSON, ok := statementJSON["distinct"]
+	if ok {
+		switch distinctJSON := distinctJSON.(type) {
+		case bool:
+			selectStatement.Distinct = distinctJSON
+		default:
+			return nil, fmt.Errorf("distinct must be a boolean")
+		}
+	}
+
+	overJSON, ok := statementJSON["over"]
+	if ok {
+		switch overJSON := overJSON.(type) {
+		case []interface{}:
+			// there are data sources joined by over
+			overClause, err := parseOver(overJSON)
+			if err != nil {
+				return nil, err
+			}
+			selectStatement.From = overClause
+		default:
+			return nil, fmt.Errorf("over element must be an array")
+		}
+	}
+
 	whereJSON, ok := statementJSON["where"]
 	if ok {
 		switch whereJSON := whereJSON.(type) {
@@ -131,14 +238,52 @@ func parseSelectStatementJSON(statementJSON map[string]interface{}) (Statement,
 	if ok {
 		switch selectJSON := selectJSON.(type) {
 		case map[string]interface{}:
-			// there is a where clause
-			selectClause, err := parseExpression(selectJSON)
+			// a single unnamed result expression
+			selectExpr, err := parseExpression(selectJSON)
+			if err != nil {
+				return nil, err
+			}
+			selectStatement.Select = ResultExpressionList{NewResultExpression(selectExpr)}
+		case []interface{}:
+			// there is a result expression list
+			selectClause, err := parseResultExpressionList(selectJSON)
 			if err != nil {
 				return nil, err
 			}
 			selectStatement.Select = selectClause
 		default:
-			return nil, fmt.Errorf("select element must be an object")
+			return nil, fmt.Errorf("select element must be an object or an array")
+		}
+		selectStatement.Select.AssignDefaultNames()
+	}
+
+	groupByJSON, ok := statementJSON["group_by"]
+	if ok {
+		switch groupByJSON := groupByJSON.(type) {
+		case []interface{}:
+			// there is a group by clause
+			groupByClause, err := parseGroupBy(groupByJSON)
+			if err != nil {
+				return nil, err
+			}
+			selectStatement.GroupBy = groupByClause
+		default:
+			return nil, fmt.Errorf("group_by element must be an array")
+		}
+	}
+
+	havingJSON, ok := statementJSON["having"]
+	if ok {
+		switch havingJSON := havingJSON.(type) {
+		case map[string]interface{}:
+			// there is a having clause
+			havingClause, err := parseBooleanExpression(havingJSON)
+			if err != nil {
+				return nil, err
+			}
+			selectStatement.Having = havingClause
+		default:
+			return nil, fmt.Errorf("having element must be an object")
 		}
 	}
 
@@ -184,6 +329,101 @@ func parseSelectStatementJSON(statementJSON map[string]interface{}) (Statement,
 	return selectStatement, nil
 }
 
+func parseOver(overJSON []interface{}) ([]DataSource, error) {
+	rv := make([]DataSource, 0, len(overJSON))
+	for _, v := range overJSON {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			path, ok := v["path"].(string)
+			if !ok {
+				return nil, fmt.Errorf("over path must be a string")
+			}
+			alias, ok := v["as"].(string)
+			if !ok {
+				return nil, fmt.Errorf("over as must be a string")
+			}
+			rv = append(rv, NewOverDataSource(NewProperty(path), alias))
+		default:
+			return nil, fmt.Errorf("members of over array must be objects")
+		}
+	}
+	return rv, nil
+}
+
+func parseResultExpressionList(selectJSON []interface{}) (ResultExpressionList, error) {
+	if len(selectJSON) == 0 {
+		return nil, fmt.Errorf("select array must not be empty")
+	}
+	rv := make(ResultExpressionList, 0, len(selectJSON))
+	for _, v := range selectJSON {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			resultExpr, err := parseResultExpression(v)
+			if err != nil {
+				return nil, err
+			}
+			rv = append(rv, resultExpr)
+		default:
+			return nil, fmt.Errorf("members of select array must be result objects")
+		}
+	}
+	return rv, nil
+}
+
+func parseResultExpression(resultJSON map[string]interface{}) (*ResultExpression, error) {
+	starJSON, ok := resultJSON["star"]
+	if ok {
+		switch starJSON := starJSON.(type) {
+		case bool:
+			if starJSON {
+				return NewStarResultExpression(), nil
+			}
+		default:
+			return nil, fmt.Errorf("star must be type boolean")
+		}
+	}
+	as := ""
+	asJSON, ok := resultJSON["as"]
+	if ok {
+		switch asJSON := asJSON.(type) {
+		case string:
+			as = asJSON
+		default:
+			return nil, fmt.Errorf("as must be type string")
+		}
+	}
+	exprJSON, ok := resultJSON["expr"]
+	if !ok {
+		return nil, fmt.Errorf("result object is missing expression")
+	}
+	switch exprJSON := exprJSON.(type) {
+	case map[string]interface{}:
+		expr, err := parseExpression(exprJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewResultExpressionWithAlias(expr, as), nil
+	}
+	return nil, fmt.Errorf("result expression must be type object")
+}
+
+func parseGroupBy(groupByJSON []interface{}) ([]Expression, error) {
+	rv := make([]Expression, 0, len(groupByJSON))
+	for _, v := range groupByJSON {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			expr, err := parseExpression(v)
+			if err != nil {
+				return nil, err
+			}
+			rv = append(rv, expr)
+		default:
+			return nil, fmt.Errorf("members of group_by array must be expression objects")
+		}
+	}
+	return rv, nil
+}
+
 func parseOrderBy(orderJSON []interface{}) ([]OrderedExpression, error) {
 	rv := make([]OrderedExpression, 0, len(orderJSON))
 	for _, v := range orderJSON {
@@ -235,7 +475,7 @@ func parseExpression(expressionJSON map[string]interface{}) (Expression, error)
 	}
 
 	switch expressionType {
-	case "compare", "and", "or", "not":
+	case "compare", "and", "or", "not", "is_null", "is_not_null", "is_missing", "is_not_missing", "is_valued", "is_not_valued", "between", "any", "all":
 		return parseBooleanExpression(expressionJSON)
 	case "literal":
 		return parseLiteral(expressionJSON)
@@ -243,6 +483,28 @@ func parseExpression(expressionJSON map[string]interface{}) (Expression, error)
 		return parseProperty(expressionJSON)
 	case "arithmetic":
 		return parseArithmetic(expressionJSON)
+	case "aggregate":
+		return parseAggregate(expressionJSON)
+	case "function":
+		return parseFunction(expressionJSON)
+	case "subscript":
+		return parseSubscript(expressionJSON)
+	case "slice":
+		return parseSlice(expressionJSON)
+	case "field":
+		return parseField(expressionJSON)
+	case "case":
+		return parseCase(expressionJSON)
+	case "array_comprehension", "first_comprehension":
+		output, overs, err := parseComprehensionArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		if expressionType == "array_comprehension" {
+			return NewArrayComprehension(output, overs), nil
+		} else {
+			return NewFirstComprehension(output, overs), nil
+		}
 	}
 
 	return nil, fmt.Errorf("Unrecognized expression type %v", expressionType)
@@ -253,6 +515,13 @@ func parseArithmetic(expressionJSON map[string]interface{}) (Expression, error)
 	if !ok {
 		return nil, fmt.Errorf("arithmetic must specify operator")
 	}
+	if operator == "neg" {
+		operand, err := parseUnaryOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewNegateOperator(operand), nil
+	}
 	left, right, err := parseBinaryOperatorArguments(expressionJSON)
 	if err != nil {
 		return nil, err
@@ -266,10 +535,229 @@ func parseArithmetic(expressionJSON map[string]interface{}) (Expression, error)
 		return NewMultiplyOperator(left, right), nil
 	case "div":
 		return NewDivideOperator(left, right), nil
+	case "mod":
+		return NewModuloOperator(left, right), nil
+	case "concat":
+		return NewConcatOperator(left, right), nil
 	}
 	return nil, fmt.Errorf("Unsupported arithmetic operator %v", operator)
 }
 
+func parseAggregate(expressionJSON map[string]interface{}) (Expression, error) {
+	function, ok := expressionJSON["function"]
+	if !ok {
+		return nil, fmt.Errorf("aggregate must specify function")
+	}
+	switch function := function.(type) {
+	case string:
+		operandJSON, ok := expressionJSON["operand"]
+		if !ok {
+			return nil, fmt.Errorf("aggregate is missing element operand")
+		}
+		switch operandJSON := operandJSON.(type) {
+		case string:
+			// only COUNT(*) is represented as a string
+			if operandJSON == "*" {
+				return NewAggregateFunction(function, nil)
+			}
+		case map[string]interface{}:
+			operand, err := parseExpression(operandJSON)
+			if err != nil {
+				return nil, err
+			}
+			return NewAggregateFunction(function, operand)
+		}
+		return nil, fmt.Errorf("aggregate operand must be an object or \"*\"")
+	}
+	return nil, fmt.Errorf("aggregate function must be a string")
+}
+
+func parseFunction(expressionJSON map[string]interface{}) (Expression, error) {
+	name, ok := expressionJSON["name"]
+	if !ok {
+		return nil, fmt.Errorf("function must specify name")
+	}
+	switch name := name.(type) {
+	case string:
+		operands := make([]Expression, 0)
+		operandsJSON, ok := expressionJSON["operands"]
+		if ok {
+			switch operandsJSON := operandsJSON.(type) {
+			case []interface{}:
+				for _, operandJSON := range operandsJSON {
+					switch operandJSON := operandJSON.(type) {
+					case map[string]interface{}:
+						operand, err := parseExpression(operandJSON)
+						if err != nil {
+							return nil, err
+						}
+						operands = append(operands, operand)
+					default:
+						return nil, fmt.Errorf("function operands must be expression objects")
+					}
+				}
+			default:
+				return nil, fmt.Errorf("function operands must be an array")
+			}
+		}
+		function, err := NewFunctionCall(name, operands)
+		if err != nil {
+			return nil, err
+		}
+		return function, nil
+	}
+	return nil, fmt.Errorf("function name must be a string")
+}
+
+func parseSubscript(expressionJSON map[string]interface{}) (Expression, error) {
+	operand, err := parseExpressionElement(expressionJSON, "subscript", "operand")
+	if err != nil {
+		return nil, err
+	}
+	index, err := parseExpressionElement(expressionJSON, "subscript", "index")
+	if err != nil {
+		return nil, err
+	}
+	return NewSubscriptOperator(operand, index), nil
+}
+
+// start and end are optional
+func parseSlice(expressionJSON map[string]interface{}) (Expression, error) {
+	operand, err := parseExpressionElement(expressionJSON, "slice", "operand")
+	if err != nil {
+		return nil, err
+	}
+	var start, end Expression
+	_, ok := expressionJSON["start"]
+	if ok {
+		start, err = parseExpressionElement(expressionJSON, "slice", "start")
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, ok = expressionJSON["end"]
+	if ok {
+		end, err = parseExpressionElement(expressionJSON, "slice", "end")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewSliceOperator(operand, start, end), nil
+}
+
+func parseField(expressionJSON map[string]interface{}) (Expression, error) {
+	operand, err := parseExpressionElement(expressionJSON, "field", "operand")
+	if err != nil {
+		return nil, err
+	}
+	field, ok := expressionJSON["field"].(string)
+	if !ok {
+		return nil, fmt.Errorf("field element field must be a string")
+	}
+	return NewFieldOperator(operand, field), nil
+}
+
+// the operand and else are optional, without an operand each when is a condition
+func parseCase(expressionJSON map[string]interface{}) (Expression, error) {
+	var operand, elseExpression Expression
+	var err error
+	_, ok := expressionJSON["operand"]
+	if ok {
+		operand, err = parseExpressionElement(expressionJSON, "case", "operand")
+		if err != nil {
+			return nil, err
+		}
+	}
+	whensJSON, ok := expressionJSON["whens"].([]interface{})
+	if !ok || len(whensJSON) == 0 {
+		return nil, fmt.Errorf("case element whens must be a non-empty array")
+	}
+	whenThens := make([]*WhenThen, len(whensJSON))
+	for i, whenJSON := range whensJSON {
+		whenJSON, ok := whenJSON.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("case whens must be objects")
+		}
+		when, err := parseExpressionElement(whenJSON, "case when", "when")
+		if err != nil {
+			return nil, err
+		}
+		then, err := parseExpressionElement(whenJSON, "case when", "then")
+		if err != nil {
+			return nil, err
+		}
+		whenThens[i] = NewWhenThen(when, then)
+	}
+	_, ok = expressionJSON["else"]
+	if ok {
+		elseExpression, err = parseExpressionElement(expressionJSON, "case", "else")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewCaseOperator(operand, whenThens, elseExpression), nil
+}
+
+func parseExpressionElement(expressionJSON map[string]interface{}, expressionType string, element string) (Expression, error) {
+	elementJSON, ok := expressionJSON[element]
+	if !ok {
+		return nil, fmt.Errorf("%v is missing element %v", expressionType, element)
+	}
+	switch elementJSON := elementJSON.(type) {
+	case map[string]interface{}:
+		return parseExpression(elementJSON)
+	}
+	return nil, fmt.Errorf("%v element %v must be an object", expressionType, element)
+}
+
+func parseComprehensionArguments(expressionJSON map[string]interface{}) (Expression, []*ComprehensionOver, error) {
+	outputJSON, ok := expressionJSON["output"].(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("comprehension element output must be an object")
+	}
+	output, err := parseExpression(outputJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	oversJSON, ok := expressionJSON["over"].([]interface{})
+	if !ok || len(oversJSON) == 0 {
+		return nil, nil, fmt.Errorf("comprehension element over must be a non-empty array")
+	}
+	overs := make([]*ComprehensionOver, 0, len(oversJSON))
+	for _, overJSON := range oversJSON {
+		overJSON, ok := overJSON.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("comprehension over clauses must be objects")
+		}
+		pathJSON, ok := overJSON["path"].(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("comprehension over clause element path must be an object")
+		}
+		path, err := parseExpression(pathJSON)
+		if err != nil {
+			return nil, nil, err
+		}
+		as, ok := overJSON["as"].(string)
+		if !ok || as == "" {
+			return nil, nil, fmt.Errorf("comprehension over clause element as must be a string")
+		}
+		var condition BooleanExpression
+		conditionJSON, ok := overJSON["if"]
+		if ok {
+			conditionJSON, ok := conditionJSON.(map[string]interface{})
+			if !ok {
+				return nil, nil, fmt.Errorf("comprehension over clause element if must be an object")
+			}
+			condition, err = parseBooleanExpression(conditionJSON)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		overs = append(overs, NewComprehensionOver(path, as, condition))
+	}
+	return output, overs, nil
+}
+
 func parseProperty(expressionJSON map[string]interface{}) (Expression, error) {
 	path, ok := expressionJSON["path"]
 	if !ok {
@@ -358,10 +846,120 @@ func parseBooleanExpression(expressionJSON map[string]interface{}) (BooleanExpre
 			return nil, err
 		}
 		return NewNotOperator(operand), nil
+	case "is_null", "is_not_valued":
+		operand, err := parseUnaryOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewIsNullOperator(operand), nil
+	case "is_not_null", "is_valued":
+		operand, err := parseUnaryOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewIsNotNullOperator(operand), nil
+	case "is_missing":
+		operand, err := parseUnaryOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewIsMissingOperator(operand), nil
+	case "is_not_missing":
+		operand, err := parseUnaryOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return NewIsNotMissingOperator(operand), nil
+	case "between":
+		return parseBetween(expressionJSON)
+	case "function":
+		function, err := parseFunction(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return function.(*FunctionCall), nil
+	case "case":
+		caseOperator, err := parseCase(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		return caseOperator.(*CaseOperator), nil
+	case "any", "all":
+		condition, over, as, err := parseCollectionOperatorArguments(expressionJSON)
+		if err != nil {
+			return nil, err
+		}
+		if expressionType == "any" {
+			return NewCollectionAnyOperator(condition, over, as), nil
+		} else {
+			return NewCollectionAllOperator(condition, over, as), nil
+		}
 	}
 	return nil, fmt.Errorf("Unrecognized expression type %v", expressionType)
 }
 
+func parseCollectionOperatorArguments(expressionJSON map[string]interface{}) (BooleanExpression, Expression, string, error) {
+	conditionJSON, ok := expressionJSON["condition"]
+	if !ok {
+		return nil, nil, "", fmt.Errorf("collection operator is missing element condition")
+	}
+	overJSON, ok := expressionJSON["over"]
+	if !ok {
+		return nil, nil, "", fmt.Errorf("collection operator is missing element over")
+	}
+	as, ok := expressionJSON["as"].(string)
+	if !ok || as == "" {
+		return nil, nil, "", fmt.Errorf("collection operator element as must be a string")
+	}
+	switch conditionJSON := conditionJSON.(type) {
+	case map[string]interface{}:
+		switch overJSON := overJSON.(type) {
+		case map[string]interface{}:
+			condition, err := parseBooleanExpression(conditionJSON)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			over, err := parseExpression(overJSON)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return condition, over, as, nil
+		}
+	}
+	return nil, nil, "", fmt.Errorf("collection operator elements condition and over must be objects")
+}
+
+func parseBetween(expressionJSON map[string]interface{}) (BooleanExpression, error) {
+	operand, err := parseUnaryOperatorArguments(expressionJSON)
+	if err != nil {
+		return nil, err
+	}
+	lowJSON, ok := expressionJSON["low"]
+	if !ok {
+		return nil, fmt.Errorf("between operator is missing element low")
+	}
+	highJSON, ok := expressionJSON["high"]
+	if !ok {
+		return nil, fmt.Errorf("between operator is missing element high")
+	}
+	switch lowJSON := lowJSON.(type) {
+	case map[string]interface{}:
+		switch highJSON := highJSON.(type) {
+		case map[string]interface{}:
+			low, err := parseExpression(lowJSON)
+			if err != nil {
+				return nil, err
+			}
+			high, err := parseExpression(highJSON)
+			if err != nil {
+				return nil, err
+			}
+			return NewBetweenOperator(operand, low, high), nil
+		}
+	}
+	return nil, fmt.Errorf("between operator elements low and high must be objects")
+}
+
 func parseCompareExpression(left Expression, right Expression, expressionJSON map[string]interface{}) (BooleanExpression, error) {
 	operator, ok := expressionJSON["operator"]
 	if ok {
@@ -378,12 +976,28 @@ func parseCompareExpression(left Expression, right Expression, expressionJSON ma
 			return NewEqualToOperator(left, right), nil
 		case "neq":
 			return NewNotEqualToOperator(left, right), nil
+		case "like":
+			return NewLikeOperator(left, right), nil
+		case "in":
+			return NewInOperator(left, right), nil
 		}
 		return nil, fmt.Errorf("unsupported comparison operator %v", operator)
 	}
 	return nil, fmt.Errorf("comparison element must specify operator")
 }
 
+func parseUnaryOperatorArguments(expressionJSON map[string]interface{}) (Expression, error) {
+	operandJSON, ok := expressionJSON["operand"]
+	if !ok {
+		return nil, fmt.Errorf("unary operator is missing element operand")
+	}
+	switch operandJSON := operandJSON.(type) {
+	case map[string]interface{}:
+		return parseExpression(operandJSON)
+	}
+	return nil, fmt.Errorf("unary operator element operand must be an object")
+}
+
 func parseUnaryBooleanOperatorArguments(expressionJSON map[string]interface{}) (BooleanExpression, error) {
 	operandJSON, ok := expressionJSON["operand"]
 	if !ok {
@@ -106,6 +106,90 @@ func TestParseBoolean(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			map[string]interface{}{
+				"type":     "compare",
+				"operator": "like",
+				"left":     map[string]interface{}{"type": "property", "path": "doc.name"},
+				"right":    map[string]interface{}{"type": "literal", "value": "Bud*"},
+			},
+			NewLikeOperator(NewProperty("doc.name"), NewLiteralString("Bud*")),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":     "compare",
+				"operator": "in",
+				"left":     map[string]interface{}{"type": "property", "path": "doc.color"},
+				"right": map[string]interface{}{"type": "literal", "value": []interface{}{
+					map[string]interface{}{"type": "literal", "value": "red"},
+					map[string]interface{}{"type": "literal", "value": "blue"},
+				}},
+			},
+			NewInOperator(NewProperty("doc.color"), NewLiteralArray([]Expression{NewLiteralString("red"), NewLiteralString("blue")})),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":    "between",
+				"operand": map[string]interface{}{"type": "property", "path": "doc.abv"},
+				"low":     map[string]interface{}{"type": "literal", "value": 5.0},
+				"high":    map[string]interface{}{"type": "literal", "value": 7.0},
+			},
+			NewBetweenOperator(NewProperty("doc.abv"), NewLiteralNumber(5.0), NewLiteralNumber(7.0)),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "function",
+				"name": "contains",
+				"operands": []interface{}{
+					map[string]interface{}{"type": "property", "path": "doc.name"},
+					map[string]interface{}{"type": "literal", "value": "ale"},
+				},
+			},
+			mustNewFunctionCall("CONTAINS", []Expression{NewProperty("doc.name"), NewLiteralString("ale")}),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "any",
+				"condition": map[string]interface{}{
+					"type":     "compare",
+					"operator": "gt",
+					"left":     map[string]interface{}{"type": "property", "path": "child.age"},
+					"right":    map[string]interface{}{"type": "literal", "value": 6.0},
+				},
+				"over": map[string]interface{}{"type": "property", "path": "doc.children"},
+				"as":   "child",
+			},
+			NewCollectionAnyOperator(NewGreaterThanOperator(NewProperty("child.age"), NewLiteralNumber(6.0)), NewProperty("doc.children"), "child"),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":    "is_null",
+				"operand": map[string]interface{}{"type": "property", "path": "doc.abv"},
+			},
+			NewIsNullOperator(NewProperty("doc.abv")),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":    "is_valued",
+				"operand": map[string]interface{}{"type": "property", "path": "doc.abv"},
+			},
+			NewIsNotNullOperator(NewProperty("doc.abv")),
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":    "is_not_missing",
+				"operand": map[string]interface{}{"type": "property", "path": "doc.abv"},
+			},
+			NewIsNotMissingOperator(NewProperty("doc.abv")),
+			nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -176,6 +260,40 @@ func TestParseArithmetic(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			map[string]interface{}{
+				"operator": "mod",
+				"left":     map[string]interface{}{"type": "literal", "value": 7.2},
+				"right":    map[string]interface{}{"type": "literal", "value": 1.5},
+			},
+			&ModuloOperator{
+				&LiteralNumber{7.2},
+				&LiteralNumber{1.5},
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"operator": "concat",
+				"left":     map[string]interface{}{"type": "literal", "value": "a"},
+				"right":    map[string]interface{}{"type": "literal", "value": "b"},
+			},
+			&ConcatOperator{
+				&LiteralString{"a"},
+				&LiteralString{"b"},
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"operator": "neg",
+				"operand":  map[string]interface{}{"type": "property", "path": "doc.abv"},
+			},
+			&NegateOperator{
+				&Property{"doc.abv"},
+			},
+			nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -191,6 +309,270 @@ func TestParseArithmetic(t *testing.T) {
 
 }
 
+func TestParseComprehension(t *testing.T) {
+
+	overJSON := []interface{}{
+		map[string]interface{}{
+			"path": map[string]interface{}{"type": "property", "path": "doc.children"},
+			"as":   "c",
+			"if": map[string]interface{}{
+				"type":     "compare",
+				"operator": "gt",
+				"left":     map[string]interface{}{"type": "property", "path": "c.age"},
+				"right":    map[string]interface{}{"type": "literal", "value": 6.0},
+			},
+		},
+		map[string]interface{}{
+			"path": map[string]interface{}{"type": "property", "path": "c.toys"},
+			"as":   "t",
+		},
+	}
+	overs := []*ComprehensionOver{
+		NewComprehensionOver(NewProperty("doc.children"), "c", NewGreaterThanOperator(NewProperty("c.age"), NewLiteralNumber(6.0))),
+		NewComprehensionOver(NewProperty("c.toys"), "t", nil),
+	}
+
+	tests := []struct {
+		input  map[string]interface{}
+		output Expression
+		err    bool
+	}{
+		{
+			map[string]interface{}{
+				"type":   "array_comprehension",
+				"output": map[string]interface{}{"type": "property", "path": "t"},
+				"over":   overJSON,
+			},
+			NewArrayComprehension(NewProperty("t"), overs),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":   "first_comprehension",
+				"output": map[string]interface{}{"type": "property", "path": "t"},
+				"over":   overJSON,
+			},
+			NewFirstComprehension(NewProperty("t"), overs),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":   "array_comprehension",
+				"output": map[string]interface{}{"type": "property", "path": "t"},
+				"over":   []interface{}{},
+			},
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"type":   "first_comprehension",
+				"output": map[string]interface{}{"type": "property", "path": "t"},
+				"over": []interface{}{
+					map[string]interface{}{"path": map[string]interface{}{"type": "property", "path": "doc.children"}},
+				},
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		expr, err := parseExpression(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("Expected error for %v", test.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if !reflect.DeepEqual(test.output, expr) {
+			t.Errorf("Expected expression %v, got %v", test.output, expr)
+		}
+	}
+
+}
+
+func TestParseCase(t *testing.T) {
+
+	abv := map[string]interface{}{"type": "property", "path": "doc.abv"}
+	five := map[string]interface{}{"type": "literal", "value": 5.0}
+
+	tests := []struct {
+		input  map[string]interface{}
+		output Expression
+		err    bool
+	}{
+		{
+			map[string]interface{}{
+				"type": "case",
+				"whens": []interface{}{
+					map[string]interface{}{
+						"when": map[string]interface{}{"type": "compare", "operator": "gt", "left": abv, "right": five},
+						"then": map[string]interface{}{"type": "literal", "value": "strong"},
+					},
+				},
+				"else": map[string]interface{}{"type": "literal", "value": "light"},
+			},
+			NewCaseOperator(nil, []*WhenThen{
+				NewWhenThen(NewGreaterThanOperator(NewProperty("doc.abv"), NewLiteralNumber(5.0)), NewLiteralString("strong")),
+			}, NewLiteralString("light")),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":    "case",
+				"operand": abv,
+				"whens": []interface{}{
+					map[string]interface{}{"when": five, "then": five},
+				},
+			},
+			NewCaseOperator(NewProperty("doc.abv"), []*WhenThen{
+				NewWhenThen(NewLiteralNumber(5.0), NewLiteralNumber(5.0)),
+			}, nil),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":  "case",
+				"whens": []interface{}{},
+			},
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"type": "case",
+				"whens": []interface{}{
+					map[string]interface{}{"when": five},
+				},
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		expr, err := parseExpression(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("Expected error for %v", test.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if !reflect.DeepEqual(test.output, expr) {
+			t.Errorf("Expected expression %v, got %v", test.output, expr)
+		}
+	}
+
+	// a case can be the where clause
+	where, err := parseBooleanExpression(tests[1].input)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(tests[1].output, where) {
+		t.Errorf("Expected expression %v, got %v", tests[1].output, where)
+	}
+
+}
+
+func TestParseSubscript(t *testing.T) {
+
+	tags := map[string]interface{}{"type": "property", "path": "doc.tags"}
+
+	tests := []struct {
+		input  map[string]interface{}
+		output Expression
+		err    bool
+	}{
+		{
+			map[string]interface{}{
+				"type":    "subscript",
+				"operand": tags,
+				"index":   map[string]interface{}{"type": "literal", "value": -1.0},
+			},
+			NewSubscriptOperator(NewProperty("doc.tags"), NewLiteralNumber(-1.0)),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":    "slice",
+				"operand": tags,
+				"start":   map[string]interface{}{"type": "literal", "value": 1.0},
+			},
+			NewSliceOperator(NewProperty("doc.tags"), NewLiteralNumber(1.0), nil),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":    "slice",
+				"operand": tags,
+				"start":   map[string]interface{}{"type": "literal", "value": 1.0},
+				"end":     map[string]interface{}{"type": "literal", "value": 3.0},
+			},
+			NewSliceOperator(NewProperty("doc.tags"), NewLiteralNumber(1.0), NewLiteralNumber(3.0)),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":    "field",
+				"operand": map[string]interface{}{"type": "function", "name": "META"},
+				"field":   "id",
+			},
+			NewFieldOperator(mustNewFunctionCall("META", []Expression{}), "id"),
+			false,
+		},
+		{
+			map[string]interface{}{
+				"type":    "subscript",
+				"operand": tags,
+			},
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"type":    "field",
+				"operand": tags,
+				"field":   1.0,
+			},
+			nil,
+			true,
+		},
+		{
+			map[string]interface{}{
+				"type":    "slice",
+				"operand": tags,
+				"end":     1.0,
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		expr, err := parseExpression(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("Expected error for %v", test.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if !reflect.DeepEqual(test.output, expr) {
+			t.Errorf("Expected expression %v, got %v", test.output, expr)
+		}
+	}
+
+}
+
 func TestParseCompareExpression(t *testing.T) {
 
 	tests := []struct {
@@ -353,6 +735,164 @@ func TestParseStatement(t *testing.T) {
 						&LiteralNumber{1.5},
 					},
 				},
+				Select:  ResultExpressionList{NewStarResultExpression()},
+				GroupBy: make([]Expression, 0),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "select",
+				"select": map[string]interface{}{
+					"type":     "aggregate",
+					"function": "count",
+					"operand":  "*",
+				},
+				"group_by": []interface{}{
+					map[string]interface{}{"type": "property", "path": "doc.type"},
+				},
+			},
+			&SelectStatement{
+				From:    make([]DataSource, 0),
+				Where:   NewLiteralBool(true),
+				Select:  ResultExpressionList{NewResultExpressionWithAlias(NewCountAggregate(nil), "$1")},
+				GroupBy: []Expression{NewProperty("doc.type")},
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "select",
+				"group_by": []interface{}{
+					map[string]interface{}{"type": "property", "path": "doc.type"},
+				},
+				"having": map[string]interface{}{
+					"type":     "compare",
+					"operator": "gt",
+					"left":     map[string]interface{}{"type": "aggregate", "function": "sum", "operand": map[string]interface{}{"type": "property", "path": "doc.abv"}},
+					"right":    map[string]interface{}{"type": "literal", "value": 100.0},
+				},
+			},
+			&SelectStatement{
+				From:    make([]DataSource, 0),
+				Where:   NewLiteralBool(true),
+				Select:  ResultExpressionList{NewStarResultExpression()},
+				GroupBy: []Expression{NewProperty("doc.type")},
+				Having:  NewGreaterThanOperator(NewSumAggregate(NewProperty("doc.abv")), NewLiteralNumber(100.0)),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "select",
+				"select": map[string]interface{}{
+					"type": "function",
+					"name": "lower",
+					"operands": []interface{}{
+						map[string]interface{}{"type": "property", "path": "doc.name"},
+					},
+				},
+			},
+			&SelectStatement{
+				From:    make([]DataSource, 0),
+				Where:   NewLiteralBool(true),
+				Select:  ResultExpressionList{NewResultExpressionWithAlias(mustNewFunctionCall("LOWER", []Expression{NewProperty("doc.name")}), "$1")},
+				GroupBy: make([]Expression, 0),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":    "select",
+				"explain": true,
+			},
+			&SelectStatement{
+				Explain: true,
+				From:    make([]DataSource, 0),
+				Where:   NewLiteralBool(true),
+				Select:  ResultExpressionList{NewStarResultExpression()},
+				GroupBy: make([]Expression, 0),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type":     "select",
+				"distinct": true,
+				"select":   map[string]interface{}{"type": "property", "path": "doc.type"},
+			},
+			&SelectStatement{
+				Distinct: true,
+				From:     make([]DataSource, 0),
+				Where:    NewLiteralBool(true),
+				Select:   ResultExpressionList{NewResultExpressionWithAlias(NewProperty("doc.type"), "type")},
+				GroupBy:  make([]Expression, 0),
+				Order:    make([]OrderedExpression, 0),
+				Limit:    -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "select",
+				"select": []interface{}{
+					map[string]interface{}{"star": true},
+					map[string]interface{}{"expr": map[string]interface{}{"type": "property", "path": "doc.address.city"}},
+					map[string]interface{}{
+						"expr": map[string]interface{}{
+							"type":     "arithmetic",
+							"operator": "mult",
+							"left":     map[string]interface{}{"type": "property", "path": "doc.abv"},
+							"right":    map[string]interface{}{"type": "literal", "value": 2.0},
+						},
+						"as": "double_abv",
+					},
+					map[string]interface{}{"expr": map[string]interface{}{"type": "literal", "value": "beer"}},
+				},
+			},
+			&SelectStatement{
+				From:  make([]DataSource, 0),
+				Where: NewLiteralBool(true),
+				Select: ResultExpressionList{
+					NewStarResultExpression(),
+					NewResultExpressionWithAlias(NewProperty("doc.address.city"), "city"),
+					NewResultExpressionWithAlias(NewMultiplyOperator(NewProperty("doc.abv"), NewLiteralNumber(2.0)), "double_abv"),
+					NewResultExpressionWithAlias(NewLiteralString("beer"), "$4"),
+				},
+				GroupBy: make([]Expression, 0),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
+			},
+			nil,
+		},
+		{
+			map[string]interface{}{
+				"type": "select",
+				"over": []interface{}{
+					map[string]interface{}{"path": "doc.employees", "as": "employee"},
+					map[string]interface{}{"path": "employee.addresses", "as": "address"},
+				},
+			},
+			&SelectStatement{
+				From: []DataSource{
+					NewOverDataSource(NewProperty("doc.employees"), "employee"),
+					NewOverDataSource(NewProperty("employee.addresses"), "address"),
+				},
+				Where:   NewLiteralBool(true),
+				Select:  ResultExpressionList{NewStarResultExpression()},
+				GroupBy: make([]Expression, 0),
+				Order:   make([]OrderedExpression, 0),
+				Limit:   -1,
 			},
 			nil,
 		},
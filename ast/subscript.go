@@ -0,0 +1,272 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"math"
+)
+
+// subscripts and slices work on arrays and on the characters of strings
+// negative positions count from the end, positions outside the array
+// or string are MISSING and anything else that is not valid is NULL
+// a string subscript is the field of an object with that name
+
+type SubscriptOperator struct {
+	Operand Expression
+	Index   Expression
+}
+
+func NewSubscriptOperator(operand, index Expression) *SubscriptOperator {
+	return &SubscriptOperator{
+		Operand: operand,
+		Index:   index,
+	}
+}
+
+func (this *SubscriptOperator) Evaluate(context Context) (interface{}, error) {
+	ov, err := this.Operand.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := this.Index.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+
+	if ov == MISSING || iv == MISSING {
+		return MISSING, nil
+	}
+
+	field, ok := iv.(string)
+	if ok {
+		return fieldValue(ov, field), nil
+	}
+
+	index, ok := integerValue(iv)
+	if !ok {
+		return nil, nil
+	}
+
+	switch ov := ov.(type) {
+	case []interface{}:
+		index, ok = absolutePosition(index, len(ov))
+		if !ok || index == len(ov) {
+			return MISSING, nil
+		}
+		return ov[index], nil
+	case string:
+		runes := []rune(ov)
+		index, ok = absolutePosition(index, len(runes))
+		if !ok || index == len(runes) {
+			return MISSING, nil
+		}
+		return string(runes[index]), nil
+	}
+	return nil, nil
+}
+
+func (this *SubscriptOperator) String() string {
+	return fmt.Sprintf("%v[%v]", this.Operand, this.Index)
+}
+
+func (this *SubscriptOperator) ReferencedProperties() []Property {
+	rv := this.Operand.ReferencedProperties()
+	return append(rv, this.Index.ReferencedProperties()...)
+}
+
+func (this *SubscriptOperator) ReferencedAggregates() []AggregateFunction {
+	rv := this.Operand.ReferencedAggregates()
+	return append(rv, this.Index.ReferencedAggregates()...)
+}
+
+// operand.Field for any operand, fields of properties are part of their path
+type FieldOperator struct {
+	Operand Expression
+	Field   string
+}
+
+func NewFieldOperator(operand Expression, field string) *FieldOperator {
+	return &FieldOperator{
+		Operand: operand,
+		Field:   field,
+	}
+}
+
+func (this *FieldOperator) Evaluate(context Context) (interface{}, error) {
+	ov, err := this.Operand.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	if ov == MISSING {
+		return MISSING, nil
+	}
+	return fieldValue(ov, this.Field), nil
+}
+
+func (this *FieldOperator) String() string {
+	return fmt.Sprintf("%v.%v", this.Operand, this.Field)
+}
+
+func (this *FieldOperator) ReferencedProperties() []Property {
+	return this.Operand.ReferencedProperties()
+}
+
+func (this *FieldOperator) ReferencedAggregates() []AggregateFunction {
+	return this.Operand.ReferencedAggregates()
+}
+
+// Start and End are optional, nil means the start or end of the operand
+// the slice includes Start but not End
+type SliceOperator struct {
+	Operand Expression
+	Start   Expression
+	End     Expression
+}
+
+func NewSliceOperator(operand, start, end Expression) *SliceOperator {
+	return &SliceOperator{
+		Operand: operand,
+		Start:   start,
+		End:     end,
+	}
+}
+
+func (this *SliceOperator) Evaluate(context Context) (interface{}, error) {
+	ov, err := this.Operand.Evaluate(context)
+	if err != nil {
+		return nil, err
+	}
+	if ov == MISSING {
+		return MISSING, nil
+	}
+
+	length := 0
+	switch ov := ov.(type) {
+	case []interface{}:
+		length = len(ov)
+	case string:
+		length = len([]rune(ov))
+	default:
+		return nil, nil
+	}
+
+	start, rv, ok, err := this.evaluatePosition(context, this.Start, 0, length)
+	if err != nil || !ok {
+		return rv, err
+	}
+	end, rv, ok, err := this.evaluatePosition(context, this.End, length, length)
+	if err != nil || !ok {
+		return rv, err
+	}
+	if start > end {
+		return MISSING, nil
+	}
+
+	switch ov := ov.(type) {
+	case []interface{}:
+		return ov[start:end], nil
+	case string:
+		return string([]rune(ov)[start:end]), nil
+	}
+	return nil, nil
+}
+
+// the position within the operand, when it is not valid the result
+// of the slice is returned instead: MISSING or NULL
+func (this *SliceOperator) evaluatePosition(context Context, position Expression, defaultPosition int, length int) (int, interface{}, bool, error) {
+	if position == nil {
+		return defaultPosition, nil, true, nil
+	}
+	pv, err := position.Evaluate(context)
+	if err != nil {
+		return 0, nil, false, err
+	}
+	if pv == MISSING {
+		return 0, MISSING, false, nil
+	}
+	index, ok := integerValue(pv)
+	if !ok {
+		return 0, nil, false, nil
+	}
+	index, ok = absolutePosition(index, length)
+	if !ok {
+		return 0, MISSING, false, nil
+	}
+	return index, nil, true, nil
+}
+
+func (this *SliceOperator) String() string {
+	start := ""
+	if this.Start != nil {
+		start = fmt.Sprintf("%v", this.Start)
+	}
+	end := ""
+	if this.End != nil {
+		end = fmt.Sprintf("%v", this.End)
+	}
+	return fmt.Sprintf("%v[%v:%v]", this.Operand, start, end)
+}
+
+func (this *SliceOperator) ReferencedProperties() []Property {
+	rv := this.Operand.ReferencedProperties()
+	if this.Start != nil {
+		rv = append(rv, this.Start.ReferencedProperties()...)
+	}
+	if this.End != nil {
+		rv = append(rv, this.End.ReferencedProperties()...)
+	}
+	return rv
+}
+
+func (this *SliceOperator) ReferencedAggregates() []AggregateFunction {
+	rv := this.Operand.ReferencedAggregates()
+	if this.Start != nil {
+		rv = append(rv, this.Start.ReferencedAggregates()...)
+	}
+	if this.End != nil {
+		rv = append(rv, this.End.ReferencedAggregates()...)
+	}
+	return rv
+}
+
+// fields that are not there are MISSING, values that are not objects
+// have no fields at all so they are NULL
+func fieldValue(value interface{}, field string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		rv, ok := value[field]
+		if !ok {
+			return MISSING
+		}
+		return rv
+	}
+	return nil
+}
+
+func integerValue(value interface{}) (int, bool) {
+	number, ok := value.(float64)
+	if !ok || number != math.Trunc(number) {
+		return 0, false
+	}
+	return int(number), true
+}
+
+// converts a position counting from the end when negative, positions
+// from 0 up to and including length are valid
+func absolutePosition(index int, length int) (int, bool) {
+	if index < 0 {
+		index = length + index
+	}
+	if index < 0 || index > length {
+		return 0, false
+	}
+	return index, true
+}
@@ -0,0 +1,130 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubscripts(t *testing.T) {
+
+	row := map[string]interface{}{
+		"tags": []interface{}{"a", "b", "c", "d"},
+		"name": "héllo",
+		"abv":  7.5,
+	}
+
+	tags := NewProperty("tags")
+	name := NewProperty("name")
+	number := func(n float64) Expression {
+		return NewLiteralNumber(n)
+	}
+
+	tests := []struct {
+		input  Expression
+		output interface{}
+	}{
+		{NewSubscriptOperator(tags, number(0)), "a"},
+		{NewSubscriptOperator(tags, number(3)), "d"},
+		{NewSubscriptOperator(tags, number(-1)), "d"},
+		{NewSubscriptOperator(tags, number(-4)), "a"},
+		{NewSubscriptOperator(tags, number(4)), MISSING},
+		{NewSubscriptOperator(tags, number(-5)), MISSING},
+		{NewSubscriptOperator(tags, number(1.5)), nil},
+		{NewSubscriptOperator(tags, NewLiteralString("0")), nil},
+		{NewSubscriptOperator(tags, NewProperty("dne")), MISSING},
+		{NewSubscriptOperator(NewLiteralArray([]Expression{number(1), number(2), number(3)}), number(0)), 1.0},
+		{NewSubscriptOperator(name, number(1)), "é"},
+		{NewSubscriptOperator(name, number(-1)), "o"},
+		{NewSubscriptOperator(name, number(5)), MISSING},
+		{NewSubscriptOperator(NewProperty("abv"), number(0)), nil},
+		{NewSubscriptOperator(NewLiteralNull(), number(0)), nil},
+		{NewSubscriptOperator(NewProperty("dne"), number(0)), MISSING},
+
+		{NewSliceOperator(tags, number(1), number(3)), []interface{}{"b", "c"}},
+		{NewSliceOperator(tags, number(1), nil), []interface{}{"b", "c", "d"}},
+		{NewSliceOperator(tags, nil, number(2)), []interface{}{"a", "b"}},
+		{NewSliceOperator(tags, nil, nil), []interface{}{"a", "b", "c", "d"}},
+		{NewSliceOperator(tags, number(-2), nil), []interface{}{"c", "d"}},
+		{NewSliceOperator(tags, number(0), number(-1)), []interface{}{"a", "b", "c"}},
+		{NewSliceOperator(tags, number(2), number(2)), []interface{}{}},
+		{NewSliceOperator(tags, number(4), nil), []interface{}{}},
+		{NewSliceOperator(tags, number(3), number(1)), MISSING},
+		{NewSliceOperator(tags, number(0), number(5)), MISSING},
+		{NewSliceOperator(tags, number(-5), nil), MISSING},
+		{NewSliceOperator(tags, number(0.5), nil), nil},
+		{NewSliceOperator(tags, nil, NewProperty("dne")), MISSING},
+		{NewSliceOperator(name, number(1), number(3)), "él"},
+		{NewSliceOperator(name, number(-2), nil), "lo"},
+		{NewSliceOperator(NewProperty("abv"), number(0), nil), nil},
+		{NewSliceOperator(NewProperty("dne"), number(0), nil), MISSING},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
+
+func TestFields(t *testing.T) {
+
+	row := map[string]interface{}{
+		"doc": map[string]interface{}{
+			"name":    "Pale Ale",
+			"brewery": map[string]interface{}{"name": "Anchor"},
+			"tags":    []interface{}{map[string]interface{}{"name": "hoppy"}},
+			"key":     "tags",
+		},
+	}
+
+	object := NewLiteralObject(map[string]Expression{
+		"a": NewLiteralObject(map[string]Expression{"b": NewLiteralNumber(1.0)}),
+	})
+
+	tests := []struct {
+		input  Expression
+		output interface{}
+	}{
+		{NewFieldOperator(NewFieldOperator(object, "a"), "b"), 1.0},
+		{NewFieldOperator(object, "b"), MISSING},
+		{NewFieldOperator(NewFieldOperator(object, "b"), "c"), MISSING},
+		{NewFieldOperator(NewProperty("doc.brewery"), "name"), "Anchor"},
+		{NewFieldOperator(NewSubscriptOperator(NewProperty("doc.tags"), NewLiteralNumber(-1.0)), "name"), "hoppy"},
+		{NewFieldOperator(NewProperty("doc.name"), "length"), nil},
+		{NewFieldOperator(NewLiteralNull(), "a"), nil},
+		{NewFieldOperator(NewProperty("doc.missing"), "a"), MISSING},
+		{NewSubscriptOperator(NewProperty("doc"), NewLiteralString("name")), "Pale Ale"},
+		{NewSubscriptOperator(NewProperty("doc"), NewProperty("doc.key")), []interface{}{map[string]interface{}{"name": "hoppy"}}},
+		{NewSubscriptOperator(NewProperty("doc"), NewLiteralString("missing")), MISSING},
+		{NewSubscriptOperator(NewProperty("doc.tags"), NewLiteralString("name")), nil},
+	}
+
+	context := NewContext(row)
+
+	for _, x := range tests {
+		result, err := x.input.Evaluate(context)
+		if err != nil {
+			t.Fatalf("Error evaluating expression: %v", err)
+		}
+		if !reflect.DeepEqual(result, x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, result)
+		}
+	}
+
+}
@@ -54,16 +54,19 @@ func (this *CouchbaseViewAccessPath) Matches(booleanFactors []ast.BooleanExpress
 	for _, booleanFactor := range booleanFactors {
 		switch booleanFactor := booleanFactor.(type) {
 		case *ast.OrOperator:
-		//FIXME handle partials later
+			// every operand must constrain the first key, otherwise
+			// documents satisfying the other operands are not scanned
+			if booleanFactor.IsSargable() && booleanFactor.GetSargProperty().Path == this.keys[0] {
+				return true
+			}
+		case *ast.IsMissingOperator:
+		// documents without the key are not in the index
 		default:
+			// only the first key can narrow the scan by itself, the
+			// following keys are used while the keys before them are equal
 			rps := booleanFactor.ReferencedProperties()
-			if len(rps) == 1 {
-				for i, key := range this.keys {
-					if i == 0 && key == rps[0].Path {
-						// FIXME only matching first index key shortcut
-						return true
-					}
-				}
+			if len(rps) == 1 && rps[0].Path == this.keys[0] {
+				return true
 			}
 		}
 	}
@@ -77,6 +80,7 @@ func (this *CouchbaseViewAccessPath) Scan(output DocumentChannel, cancel CancelC
 	go WalkViewInBatches(viewRowsChannel, this.dataSource.bucket, this.ddoc, this.view, options, BATCH_SIZE)
 	for row := range viewRowsChannel {
 		rowdoc := map[string]interface{}{
+			"key": row.Key,
 			"meta": map[string]interface{}{
 				"id": row.ID,
 			},
@@ -86,71 +90,96 @@ func (this *CouchbaseViewAccessPath) Scan(output DocumentChannel, cancel CancelC
 	}
 }
 
-func (this *CouchbaseViewAccessPath) UpdateStats() {
+// Reduce queries the view with the reduce function enabled
+// each output document contains the key and reduced value of one row
+func (this *CouchbaseViewAccessPath) Reduce(output DocumentChannel, cancel CancelChannel, options map[string]interface{}) {
+	defer close(output)
 
-	log.Printf("Starting UpdateStats for %v", this)
+	options["reduce"] = true
+	viewRowsChannel := make(chan couchbase.ViewRow)
+	go WalkViewInBatches(viewRowsChannel, this.dataSource.bucket, this.ddoc, this.view, options, BATCH_SIZE)
+	for row := range viewRowsChannel {
+		rowdoc := map[string]interface{}{
+			"key":   row.Key,
+			"value": row.Value,
+		}
+		output <- rowdoc
+	}
+}
 
-	// only try to address single column stats here
-	if len(this.keys) == 1 {
+func (this *CouchbaseViewAccessPath) UpdateStats() {
 
-		pathStat := stats.DefaultPathStats(MIN_KEY, MAX_KEY)
+	log.Printf("Starting UpdateStats for %v", this)
 
-		vres, err := this.dataSource.bucket.View(this.ddoc, this.view, map[string]interface{}{"reduce": false, "limit": 0})
-		if err != nil {
-			log.Printf("Unable to determine cardinality of view, defaulting to MAX")
-		} else {
-			pathStat.Rows = vres.TotalRows
-			pathStat.DistinctValues = vres.TotalRows
-		}
+	// only try to address stats of the first key here
+	// indexes with more than one key emit array keys, at group level 1
+	// their rows have arrays holding just the value of the first key
+	pathStat := stats.DefaultPathStats(MIN_KEY, MAX_KEY)
+
+	vres, err := this.dataSource.bucket.View(this.ddoc, this.view, map[string]interface{}{"reduce": false, "limit": 0})
+	if err != nil {
+		log.Printf("Unable to determine cardinality of view, defaulting to MAX")
+	} else {
+		pathStat.Rows = vres.TotalRows
+		pathStat.DistinctValues = vres.TotalRows
+	}
 
-		// try to gather deeper stats
-		targetCountPerQuantile := pathStat.Rows / pathStat.NumQuantiles()
-		options := map[string]interface{}{"group_level": 1}
-		viewRowsChannel := make(chan couchbase.ViewRow)
-		go WalkViewInBatches(viewRowsChannel, this.dataSource.bucket, this.ddoc, this.view, options, BATCH_SIZE)
-		distinctRows := 0
-		currentQuantile := stats.QuantileRange{}
-		runningCount := 0
-		numQuantilesBuilt := 0
-		for row := range viewRowsChannel {
-			if distinctRows == 0 {
-				pathStat.MinValue = row.Key
-			}
-			if currentQuantile.Count == 0 {
-				currentQuantile.Start = row.Key
-			}
-			pathStat.MaxValue = row.Key
-			currentQuantile.End = row.Key
-			distinctRows++
-			// expect result to be _stats reduce
-			switch stats_reduce := row.Value.(type) {
-			case map[string]interface{}:
-				switch stats_count := stats_reduce["count"].(type) {
-				case float64:
-					pathStat.MostFrequentValues.Consider(row.Key, stats_count)
-					currentQuantile.Count = currentQuantile.Count + int(stats_count)
-					runningCount = runningCount + int(stats_count)
+	// try to gather deeper stats
+	targetCountPerQuantile := pathStat.Rows / pathStat.NumQuantiles()
+	options := map[string]interface{}{"group_level": 1}
+	viewRowsChannel := make(chan couchbase.ViewRow)
+	go WalkViewInBatches(viewRowsChannel, this.dataSource.bucket, this.ddoc, this.view, options, BATCH_SIZE)
+	distinctRows := 0
+	currentQuantile := stats.QuantileRange{}
+	runningCount := 0
+	numQuantilesBuilt := 0
+	for row := range viewRowsChannel {
+		key := row.Key
+		if len(this.keys) > 1 {
+			switch arrayKey := row.Key.(type) {
+			case []interface{}:
+				if len(arrayKey) > 0 {
+					key = arrayKey[0]
 				}
 			}
-
-			if currentQuantile.Count > targetCountPerQuantile {
-				//close out the quantile
-				pathStat.Quantiles = append(pathStat.Quantiles, currentQuantile)
-				numQuantilesBuilt = numQuantilesBuilt + 1
-				// update the target counts (we may have overshot because of a large bin)
-				targetCountPerQuantile = (pathStat.Rows - runningCount) / (pathStat.NumQuantiles() - numQuantilesBuilt)
-				//empty out a new quantile
-				currentQuantile = stats.QuantileRange{}
+		}
+		if distinctRows == 0 {
+			pathStat.MinValue = key
+		}
+		if currentQuantile.Count == 0 {
+			currentQuantile.Start = key
+		}
+		pathStat.MaxValue = key
+		currentQuantile.End = key
+		distinctRows++
+		// expect result to be _stats reduce
+		switch stats_reduce := row.Value.(type) {
+		case map[string]interface{}:
+			switch stats_count := stats_reduce["count"].(type) {
+			case float64:
+				pathStat.MostFrequentValues.Consider(key, stats_count)
+				currentQuantile.Count = currentQuantile.Count + int(stats_count)
+				runningCount = runningCount + int(stats_count)
 			}
 		}
-		// close out the last quantile
-		pathStat.Quantiles = append(pathStat.Quantiles, currentQuantile)
-		numQuantilesBuilt = numQuantilesBuilt + 1
-		pathStat.DistinctValues = distinctRows
 
-		this.dataSource.pathStats[this.keys[0]] = pathStat
-		log.Printf("%v", pathStat)
+		if currentQuantile.Count > targetCountPerQuantile {
+			//close out the quantile
+			pathStat.Quantiles = append(pathStat.Quantiles, currentQuantile)
+			numQuantilesBuilt = numQuantilesBuilt + 1
+			// update the target counts (we may have overshot because of a large bin)
+			targetCountPerQuantile = (pathStat.Rows - runningCount) / (pathStat.NumQuantiles() - numQuantilesBuilt)
+			//empty out a new quantile
+			currentQuantile = stats.QuantileRange{}
+		}
 	}
+	// close out the last quantile
+	pathStat.Quantiles = append(pathStat.Quantiles, currentQuantile)
+	numQuantilesBuilt = numQuantilesBuilt + 1
+	pathStat.DistinctValues = distinctRows
+
+	this.dataSource.pathStats[this.keys[0]] = pathStat
+	log.Printf("%v", pathStat)
 
 	log.Printf("Finished UpdateStats for %v", this)
 
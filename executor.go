@@ -20,6 +20,7 @@ import (
 
 type Executor interface {
 	ExecutePlan(w http.ResponseWriter, plan plan.Operator)
+	ExplainPlan(w http.ResponseWriter, plan plan.Operator, candidates []plan.Operator)
 }
 
 type CouchbaseExecutor struct {
@@ -62,3 +63,32 @@ func (this *CouchbaseExecutor) ExecutePlan(w http.ResponseWriter, plan plan.Oper
 	fmt.Fprint(w, "}\n")
 
 }
+
+// write the explanation of the plan instead of executing it
+// if candidates is not nil, every plan considered is also included
+func (this *CouchbaseExecutor) ExplainPlan(w http.ResponseWriter, optimalPlan plan.Operator, candidates []plan.Operator) {
+
+	rv := map[string]interface{}{
+		"plan":       optimalPlan.Explain(),
+		"total_cost": optimalPlan.TotalCost(),
+	}
+
+	if candidates != nil {
+		candidateExplains := make([]map[string]interface{}, 0, len(candidates))
+		for _, candidate := range candidates {
+			candidateExplains = append(candidateExplains, map[string]interface{}{
+				"plan":       candidate.Explain(),
+				"total_cost": candidate.TotalCost(),
+			})
+		}
+		rv["candidates"] = candidateExplains
+	}
+
+	body, err := json.MarshalIndent(rv, "", "    ")
+	if err != nil {
+		log.Printf("Unable to format plan to display %#v, %v", rv, err)
+		http.Error(w, fmt.Sprintf("Unable to format plan: %v", err), 500)
+		return
+	}
+	fmt.Fprintf(w, "%v\n", string(body))
+}
@@ -69,6 +69,7 @@ func main() {
 	// api
 	r.HandleFunc("/api", welcome).Methods("GET")
 	r.Handle("/api/{bucket}/_query_ast", http.HandlerFunc(bucketQueryAST)).Methods("POST")
+	r.Handle("/api/_query", http.HandlerFunc(query)).Methods("GET", "POST")
 	r.Handle("/api/{bucket}/_query", http.HandlerFunc(bucketQuery)).Methods("GET", "POST")
 	r.Handle("/", http.RedirectHandler("/_static/index.html", 302))
 	log.Printf("listening rest on: %v", *addr)
@@ -93,6 +94,21 @@ func showError(w http.ResponseWriter, r *http.Request,
 	http.Error(w, msg, code)
 }
 
+// queries sent here must name their bucket in the FROM clause
+func query(w http.ResponseWriter, r *http.Request) {
+	statement, err := parseQueryString(w, r, "")
+	if err != nil {
+		return
+	}
+
+	if len(statement.GetFrom()) == 0 {
+		showError(w, r, "Query must specify a FROM clause", 400)
+		return
+	}
+
+	doExecuteStatement(w, r, statement)
+}
+
 func bucketQuery(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	bucket := vars["bucket"]
@@ -102,6 +118,25 @@ func bucketQuery(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the query reads the bucket, unless it has its own FROM
+	statement, err := parseQueryString(w, r, bucket)
+	if err != nil {
+		return
+	}
+
+	from := statement.GetFrom()
+	if from[0].GetName() != bucket {
+		showError(w, r, fmt.Sprintf("Query FROM %v does not match bucket %v", from[0].GetName(), bucket), 400)
+		return
+	}
+
+	doExecuteStatement(w, r, statement)
+}
+
+// parse the query from the request, any error has already
+// been reported to the client when an error is returned
+// a query without a FROM clause reads the bucket, if there is one
+func parseQueryString(w http.ResponseWriter, r *http.Request, bucket string) (ast.Statement, error) {
 	queryString := r.FormValue("q")
 	if queryString == "" && r.Method == "POST" {
 		queryStringBytes, err := ioutil.ReadAll(r.Body)
@@ -112,21 +147,18 @@ func bucketQuery(w http.ResponseWriter, r *http.Request) {
 
 	if queryString == "" {
 		showError(w, r, "Missing required query string", 500)
-		return
+		return nil, fmt.Errorf("Missing required query string")
 	} else {
 		log.Printf("Query String: %v", queryString)
 	}
 
-	statement, err := unqlParser.Parse(queryString)
+	statement, err := unqlParser.ParseWithDefaultDataSource(queryString, bucket)
 	if err != nil {
 		showError(w, r, err.Error(), 500)
-		return
+		return nil, err
 	}
 
-	// add the from
-	statement.SetFrom([]ast.DataSource{ast.NewNamedDataSource(bucket)})
-
-	doExecuteStatement(w, r, statement)
+	return statement, nil
 }
 
 func bucketQueryAST(w http.ResponseWriter, r *http.Request) {
@@ -176,7 +208,16 @@ func doExecuteStatement(w http.ResponseWriter, r *http.Request, s ast.Statement)
 
 	if len(plans) > 0 {
 		optimalPlan := optimizer.ChooseOptimalPlan(plans)
-		executor.ExecutePlan(w, optimalPlan)
+		if s.IsExplain() {
+			// optionally show all the plans that were considered
+			var candidates []plan.Operator
+			if r.FormValue("all_plans") == "true" {
+				candidates = plans
+			}
+			executor.ExplainPlan(w, optimalPlan, candidates)
+		} else {
+			executor.ExecutePlan(w, optimalPlan)
+		}
 	} else {
 		showError(w, r, "Unable to determine an appropriate plan", 500)
 	}
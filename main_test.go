@@ -0,0 +1,93 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+	"github.com/couchbaselabs/tuqqedin/parser"
+	"github.com/couchbaselabs/tuqqedin/plan"
+	"github.com/gorilla/mux"
+)
+
+// mockDataSourceManager only knows the beer bucket
+type mockDataSourceManager struct{}
+
+func (this *mockDataSourceManager) GetDataSource(name string) (datasource.DataSource, error) {
+	if name != "beer" {
+		return nil, fmt.Errorf("No such datasource %v", name)
+	}
+	return nil, nil
+}
+
+// recordingPlanner keeps the statement it was asked to plan
+type recordingPlanner struct {
+	statement ast.Statement
+}
+
+func (this *recordingPlanner) Plan(statement ast.Statement) ([]plan.Operator, error) {
+	this.statement = statement
+	return nil, fmt.Errorf("Not planned")
+}
+
+func TestBucketQueryResolvesAliases(t *testing.T) {
+
+	dataSourceManager = &mockDataSourceManager{}
+	unqlParser = parser.NewUnqlParser()
+	recorder := &recordingPlanner{}
+	planner = recorder
+
+	router := mux.NewRouter()
+	router.Handle("/api/{bucket}/_query", http.HandlerFunc(bucketQuery))
+
+	tests := []struct {
+		query   string
+		planned bool
+	}{
+		// the bucket is added to a query without FROM before its aliases are resolved
+		{"SELECT beer.abv WHERE beer.type = \"ale\"", true},
+		{"SELECT beer.abv FROM beer WHERE beer.type = \"ale\"", true},
+		{"SELECT beer.abv FROM wine WHERE beer.type = \"ale\"", false},
+	}
+
+	for _, x := range tests {
+		recorder.statement = nil
+		request, err := http.NewRequest("GET", "/api/beer/_query?q="+url.QueryEscape(x.query), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		router.ServeHTTP(httptest.NewRecorder(), request)
+
+		statement := recorder.statement
+		if (statement != nil) != x.planned {
+			t.Fatalf("Expected planned %v for %v", x.planned, x.query)
+		}
+		if statement == nil {
+			continue
+		}
+		if name := statement.GetFrom()[0].GetName(); name != "beer" {
+			t.Errorf("Expected %v to read beer, got %v", x.query, name)
+		}
+		if path := statement.GetSelect()[0].Expr.(*ast.Property).Path; path != "doc.abv" {
+			t.Errorf("Expected %v to select doc.abv, got %v", x.query, path)
+		}
+		properties := statement.GetWhere().ReferencedProperties()
+		if len(properties) != 1 || properties[0].Path != "doc.type" {
+			t.Errorf("Expected %v to filter on doc.type, got %v", x.query, properties)
+		}
+	}
+
+}
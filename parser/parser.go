@@ -18,4 +18,7 @@ var DebugGrammar = false
 
 type Parser interface {
 	Parse(input string) (returnStatement ast.Statement, err error)
+	// a query without a FROM clause reads the named data source, its
+	// properties are resolved exactly as if it had been written in FROM
+	ParseWithDefaultDataSource(input string, dataSource string) (returnStatement ast.Statement, err error)
 }
@@ -16,15 +16,14 @@ type family struct {
   a []dfa
   endcase int
 }
-var a0 [43]dfa
+var a0 [70]dfa
 var a []family
 func init() {
 a = make([]family, 1)
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
   default:
     switch {
@@ -34,7 +33,8 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
   default:
     switch {
@@ -62,20 +62,19 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 46: return -1
+  case 46: return 2
   default:
     switch {
-    case 48 <= r && r <= 57: return 3
+    case 48 <= r && r <= 57: return 1
     default: return -1
     }
   }
   panic("unreachable")
 }
-acc[3] = true
-fun[3] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
   case 46: return -1
   default:
@@ -86,12 +85,13 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+acc[3] = true
+fun[3] = func(r rune) int {
   switch(r) {
-  case 46: return 2
+  case 46: return -1
   default:
     switch {
-    case 48 <= r && r <= 57: return 1
+    case 48 <= r && r <= 57: return 3
     default: return -1
     }
   }
@@ -104,80 +104,80 @@ a0[1].id = 1
 {
 var acc [7]bool
 var fun [7]func(rune) int
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 92: return 2
-  case 34: return 3
+  case 34: return 1
+  case 92: return -1
   default:
     switch {
-    default: return 4
+    default: return -1
     }
   }
   panic("unreachable")
 }
-fun[6] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 92: return 2
-  case 34: return 3
+  case 34: return 2
+  case 92: return 3
   default:
     switch {
-    default: return 6
+    default: return 4
     }
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
-  case 34: return 3
-  case 92: return 2
+  case 34: return -1
+  case 92: return -1
   default:
     switch {
-    default: return 4
+    default: return -1
     }
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 92: return -1
-  case 34: return 1
+  case 34: return 5
+  case 92: return 5
   default:
     switch {
-    default: return -1
+    default: return 5
     }
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 92: return 2
-  case 34: return 3
+  case 34: return 2
+  case 92: return 3
   default:
     switch {
-    default: return 6
+    default: return 4
     }
   }
   panic("unreachable")
 }
-acc[3] = true
-fun[3] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 34: return -1
-  case 92: return -1
+  case 34: return 2
+  case 92: return 3
   default:
     switch {
-    default: return -1
+    default: return 6
     }
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 92: return 5
-  case 34: return 5
+  case 34: return 2
+  case 92: return 3
   default:
     switch {
-    default: return 5
+    default: return 6
     }
   }
   panic("unreachable")
@@ -211,25 +211,25 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[6] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
-  case 92: return 3
-  case 39: return 2
+  case 39: return -1
+  case 92: return -1
   default:
     switch {
-    default: return 6
+    default: return -1
     }
   }
   panic("unreachable")
 }
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 39: return -1
-  case 92: return -1
+  case 39: return 5
+  case 92: return 5
   default:
     switch {
-    default: return -1
+    default: return 5
     }
   }
   panic("unreachable")
@@ -245,18 +245,18 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 92: return 5
-  case 39: return 5
+  case 39: return 2
+  case 92: return 3
   default:
     switch {
-    default: return 5
+    default: return 6
     }
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
   case 39: return 2
   case 92: return 3
@@ -274,17 +274,16 @@ a0[3].id = 3
 {
 var acc [9]bool
 var fun [9]func(rune) int
-acc[8] = true
-fun[8] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 84: return -1
-  case 116: return -1
-  case 117: return -1
-  case 85: return -1
   case 69: return -1
-  case 101: return -1
   case 82: return -1
+  case 84: return 1
+  case 85: return -1
+  case 101: return -1
   case 114: return -1
+  case 116: return 2
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -292,14 +291,14 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[7] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
+  case 69: return -1
+  case 82: return 3
+  case 84: return -1
   case 85: return -1
-  case 69: return 8
   case 101: return -1
-  case 82: return -1
   case 114: return -1
-  case 84: return -1
   case 116: return -1
   case 117: return -1
   default:
@@ -309,16 +308,16 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 101: return -1
-  case 82: return 6
-  case 114: return -1
+  case 69: return -1
+  case 82: return -1
   case 84: return -1
+  case 85: return -1
+  case 101: return -1
+  case 114: return 4
   case 116: return -1
   case 117: return -1
-  case 85: return -1
-  case 69: return -1
   default:
     switch {
     default: return -1
@@ -326,16 +325,16 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 114: return 3
+  case 69: return -1
+  case 82: return -1
   case 84: return -1
+  case 85: return 5
+  case 101: return -1
+  case 114: return -1
   case 116: return -1
   case 117: return -1
-  case 85: return -1
-  case 69: return -1
-  case 101: return -1
-  case 82: return -1
   default:
     switch {
     default: return -1
@@ -345,14 +344,14 @@ fun[2] = func(r rune) int {
 }
 fun[4] = func(r rune) int {
   switch(r) {
-  case 85: return -1
   case 69: return -1
-  case 101: return 5
   case 82: return -1
-  case 114: return -1
   case 84: return -1
+  case 85: return -1
+  case 101: return -1
+  case 114: return -1
   case 116: return -1
-  case 117: return -1
+  case 117: return 6
   default:
     switch {
     default: return -1
@@ -360,17 +359,16 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[5] = true
 fun[5] = func(r rune) int {
   switch(r) {
+  case 69: return 7
+  case 82: return -1
   case 84: return -1
-  case 116: return -1
-  case 117: return -1
   case 85: return -1
-  case 69: return -1
   case 101: return -1
-  case 82: return -1
   case 114: return -1
+  case 116: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -381,13 +379,13 @@ fun[5] = func(r rune) int {
 fun[6] = func(r rune) int {
   switch(r) {
   case 69: return -1
-  case 101: return -1
   case 82: return -1
-  case 114: return -1
   case 84: return -1
+  case 85: return -1
+  case 101: return 8
+  case 114: return -1
   case 116: return -1
   case 117: return -1
-  case 85: return 7
   default:
     switch {
     default: return -1
@@ -395,16 +393,17 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[7] = true
+fun[7] = func(r rune) int {
   switch(r) {
-  case 84: return 1
-  case 116: return 2
-  case 117: return -1
-  case 85: return -1
   case 69: return -1
-  case 101: return -1
   case 82: return -1
+  case 84: return -1
+  case 85: return -1
+  case 101: return -1
   case 114: return -1
+  case 116: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -412,16 +411,17 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+acc[8] = true
+fun[8] = func(r rune) int {
   switch(r) {
-  case 85: return -1
   case 69: return -1
-  case 101: return -1
   case 82: return -1
-  case 114: return -1
   case 84: return -1
+  case 85: return -1
+  case 101: return -1
+  case 114: return -1
   case 116: return -1
-  case 117: return 4
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -438,16 +438,16 @@ var acc [11]bool
 var fun [11]func(rune) int
 fun[0] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return 1
-  case 76: return -1
-  case 69: return -1
-  case 115: return -1
-  case 70: return 2
-  case 101: return -1
   case 65: return -1
+  case 69: return -1
+  case 70: return 1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return -1
+  case 102: return 2
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -455,18 +455,18 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[7] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 65: return -1
+  case 65: return 3
+  case 69: return -1
+  case 70: return -1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
-  case 108: return 8
+  case 101: return -1
   case 102: return -1
-  case 76: return -1
-  case 69: return -1
+  case 108: return -1
   case 115: return -1
-  case 70: return -1
-  case 101: return -1
   default:
     switch {
     default: return -1
@@ -474,18 +474,18 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 83: return -1
-  case 97: return 7
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return -1
   case 70: return -1
+  case 76: return -1
+  case 83: return -1
+  case 97: return 4
   case 101: return -1
-  case 65: return -1
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -493,18 +493,18 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[9] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return -1
   case 70: return -1
-  case 101: return 10
-  case 65: return -1
+  case 76: return 5
   case 83: return -1
   case 97: return -1
-  case 108: return -1
+  case 101: return -1
   case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -512,18 +512,18 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
-  case 69: return 6
-  case 115: return -1
-  case 70: return -1
-  case 101: return -1
   case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 108: return 6
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -531,18 +531,18 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[8] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 83: return -1
-  case 97: return -1
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return 9
   case 70: return -1
+  case 76: return -1
+  case 83: return 7
+  case 97: return -1
   case 101: return -1
-  case 65: return -1
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -550,18 +550,18 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 70: return -1
-  case 101: return -1
   case 65: return -1
-  case 83: return 5
+  case 69: return -1
+  case 70: return -1
+  case 76: return -1
+  case 83: return -1
   case 97: return -1
-  case 108: return -1
+  case 101: return -1
   case 102: return -1
-  case 76: return -1
-  case 69: return -1
-  case 115: return -1
+  case 108: return -1
+  case 115: return 8
   default:
     switch {
     default: return -1
@@ -569,18 +569,18 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return -1
-  case 76: return 4
-  case 69: return -1
-  case 115: return -1
-  case 70: return -1
-  case 101: return -1
   case 65: return -1
+  case 69: return 9
+  case 70: return -1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -588,19 +588,18 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[6] = true
-fun[6] = func(r rune) int {
+fun[8] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return -1
   case 70: return -1
-  case 101: return -1
-  case 65: return -1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return 10
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -608,18 +607,19 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+acc[9] = true
+fun[9] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return -1
   case 70: return -1
-  case 101: return -1
-  case 65: return 3
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -630,16 +630,16 @@ fun[2] = func(r rune) int {
 acc[10] = true
 fun[10] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 102: return -1
-  case 76: return -1
+  case 65: return -1
   case 69: return -1
-  case 115: return -1
   case 70: return -1
-  case 101: return -1
-  case 65: return -1
+  case 76: return -1
   case 83: return -1
   case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 108: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -652,13 +652,16 @@ a0[5].f = fun[:]
 a0[5].id = 5
 }
 {
-var acc [5]bool
-var fun [5]func(rune) int
-fun[1] = func(r rune) int {
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
+  case 76: return -1
+  case 78: return 1
+  case 85: return -1
   case 108: return -1
-  case 110: return -1
-  case 117: return 2
+  case 110: return 2
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -666,11 +669,14 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
+  case 76: return -1
+  case 78: return -1
+  case 85: return 3
+  case 108: return -1
   case 110: return -1
   case 117: return -1
-  case 108: return 4
   default:
     switch {
     default: return -1
@@ -678,11 +684,14 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
+  case 76: return -1
+  case 78: return -1
+  case 85: return -1
   case 108: return -1
-  case 110: return 1
-  case 117: return -1
+  case 110: return -1
+  case 117: return 4
   default:
     switch {
     default: return -1
@@ -690,12 +699,14 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[4] = true
-fun[4] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
+  case 76: return 5
+  case 78: return -1
+  case 85: return -1
+  case 108: return -1
   case 110: return -1
   case 117: return -1
-  case 108: return -1
   default:
     switch {
     default: return -1
@@ -703,11 +714,14 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
+  case 76: return -1
+  case 78: return -1
+  case 85: return -1
+  case 108: return 6
   case 110: return -1
   case 117: return -1
-  case 108: return 3
   default:
     switch {
     default: return -1
@@ -715,25 +729,14 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[6].acc = acc[:]
-a0[6].f = fun[:]
-a0[6].id = 6
-}
-{
-var acc [13]bool
-var fun [13]func(rune) int
-fun[3] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 99: return -1
-  case 115: return -1
-  case 67: return -1
-  case 108: return 4
-  case 69: return -1
-  case 116: return -1
-  case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
+  case 76: return 7
+  case 78: return -1
+  case 85: return -1
+  case 108: return -1
+  case 110: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -741,18 +744,14 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[11] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
-  case 83: return -1
-  case 101: return -1
-  case 84: return 12
   case 76: return -1
-  case 99: return -1
-  case 115: return -1
+  case 78: return -1
+  case 85: return -1
+  case 108: return 8
+  case 110: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -760,19 +759,15 @@ fun[11] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[12] = true
-fun[12] = func(r rune) int {
+acc[7] = true
+fun[7] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
-  case 83: return -1
-  case 101: return -1
-  case 84: return -1
   case 76: return -1
-  case 99: return -1
+  case 78: return -1
+  case 85: return -1
+  case 108: return -1
+  case 110: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -780,18 +775,15 @@ fun[12] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[8] = true
+fun[8] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
-  case 83: return 1
-  case 101: return -1
-  case 84: return -1
   case 76: return -1
-  case 99: return -1
-  case 115: return 2
+  case 78: return -1
+  case 85: return -1
+  case 108: return -1
+  case 110: return -1
+  case 117: return -1
   default:
     switch {
     default: return -1
@@ -799,17 +791,24 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+a0[6].acc = acc[:]
+a0[6].f = fun[:]
+a0[6].id = 6
+}
+{
+var acc [15]bool
+var fun [15]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 108: return -1
-  case 69: return 8
-  case 116: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return 1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return 2
+  case 110: return -1
   case 115: return -1
   default:
     switch {
@@ -818,17 +817,17 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[6] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return 7
+  case 71: return -1
+  case 73: return 3
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
   case 115: return -1
   default:
     switch {
@@ -837,18 +836,17 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[7] = true
-fun[7] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 103: return -1
+  case 105: return 4
+  case 109: return -1
+  case 110: return -1
   case 115: return -1
   default:
     switch {
@@ -857,18 +855,18 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 101: return 5
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return 5
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
   case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
-  case 83: return -1
   default:
     switch {
     default: return -1
@@ -876,18 +874,18 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 99: return -1
-  case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return 3
-  case 84: return -1
-  case 76: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return 6
   default:
     switch {
     default: return -1
@@ -895,18 +893,18 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[9] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 116: return -1
-  case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return 7
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
   case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return 10
   default:
     switch {
     default: return -1
@@ -914,18 +912,18 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[8] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 116: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return 9
-  case 99: return -1
-  case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return 8
   default:
     switch {
     default: return -1
@@ -933,18 +931,18 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 67: return -1
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
+  case 71: return -1
+  case 73: return 9
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return 6
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -952,17 +950,17 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[10] = func(r rune) int {
+fun[8] = func(r rune) int {
   switch(r) {
-  case 67: return 11
-  case 108: return -1
-  case 69: return -1
-  case 116: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
   case 83: return -1
-  case 101: return -1
-  case 84: return -1
-  case 76: return -1
-  case 99: return -1
+  case 103: return -1
+  case 105: return 10
+  case 109: return -1
+  case 110: return -1
   case 115: return -1
   default:
     switch {
@@ -971,23 +969,37 @@ fun[10] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[7].acc = acc[:]
-a0[7].f = fun[:]
-a0[7].id = 7
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return 11
+  case 83: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
 }
-{
-var acc [11]bool
-var fun [11]func(rune) int
-fun[1] = func(r rune) int {
+fun[10] = func(r rune) int {
   switch(r) {
-  case 104: return 7
-  case 119: return -1
-  case 101: return -1
-  case 87: return -1
-  case 72: return -1
-  case 82: return -1
-  case 69: return -1
-  case 114: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return 12
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -995,16 +1007,18 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+fun[11] = func(r rune) int {
   switch(r) {
-  case 82: return 5
-  case 69: return -1
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
-  case 101: return -1
-  case 87: return -1
-  case 72: return -1
+  case 71: return 13
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -1012,16 +1026,18 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[12] = func(r rune) int {
   switch(r) {
-  case 114: return -1
-  case 104: return -1
-  case 119: return 1
-  case 101: return -1
-  case 87: return 2
-  case 72: return -1
-  case 82: return -1
-  case 69: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return -1
+  case 103: return 14
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -1029,16 +1045,19 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[7] = func(r rune) int {
+acc[13] = true
+fun[13] = func(r rune) int {
   switch(r) {
-  case 72: return -1
-  case 82: return -1
-  case 69: return -1
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
-  case 101: return 8
-  case 87: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -1046,16 +1065,19 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[8] = func(r rune) int {
+acc[14] = true
+fun[14] = func(r rune) int {
   switch(r) {
-  case 82: return -1
-  case 69: return -1
-  case 114: return 9
-  case 104: return -1
-  case 119: return -1
-  case 101: return -1
-  case 87: return -1
-  case 72: return -1
+  case 71: return -1
+  case 73: return -1
+  case 77: return -1
+  case 78: return -1
+  case 83: return -1
+  case 103: return -1
+  case 105: return -1
+  case 109: return -1
+  case 110: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -1063,17 +1085,27 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[10] = true
-fun[10] = func(r rune) int {
+a0[7].acc = acc[:]
+a0[7].f = fun[:]
+a0[7].id = 7
+}
+{
+var acc [13]bool
+var fun [13]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 82: return -1
+  case 65: return -1
+  case 68: return -1
   case 69: return -1
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return 1
+  case 97: return -1
+  case 100: return -1
   case 101: return -1
-  case 87: return -1
-  case 72: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return 2
   default:
     switch {
     default: return -1
@@ -1081,16 +1113,20 @@ fun[10] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[9] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 104: return -1
-  case 119: return -1
-  case 101: return 10
-  case 87: return -1
-  case 72: return -1
-  case 82: return -1
+  case 65: return 3
+  case 68: return -1
   case 69: return -1
-  case 114: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1098,17 +1134,20 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[6] = true
-fun[6] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 104: return -1
-  case 119: return -1
-  case 101: return -1
-  case 87: return -1
-  case 72: return -1
-  case 82: return -1
+  case 65: return -1
+  case 68: return -1
   case 69: return -1
-  case 114: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return 4
+  case 100: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1118,14 +1157,18 @@ fun[6] = func(r rune) int {
 }
 fun[3] = func(r rune) int {
   switch(r) {
-  case 82: return -1
-  case 69: return 4
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
+  case 65: return -1
+  case 68: return -1
+  case 69: return -1
+  case 76: return 5
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
   case 101: return -1
-  case 87: return -1
-  case 72: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1133,16 +1176,20 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
-  case 101: return -1
-  case 87: return -1
-  case 72: return 3
-  case 82: return -1
+  case 65: return -1
+  case 68: return -1
   case 69: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
+  case 101: return -1
+  case 108: return 6
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1152,14 +1199,18 @@ fun[2] = func(r rune) int {
 }
 fun[5] = func(r rune) int {
   switch(r) {
-  case 82: return -1
-  case 69: return 6
-  case 114: return -1
-  case 104: return -1
-  case 119: return -1
+  case 65: return -1
+  case 68: return -1
+  case 69: return -1
+  case 76: return -1
+  case 85: return 7
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
   case 101: return -1
-  case 87: return -1
-  case 72: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1167,23 +1218,62 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[8].acc = acc[:]
-a0[8].f = fun[:]
-a0[8].id = 8
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 68: return -1
+  case 69: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return 8
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
 }
-{
-var acc [11]bool
-var fun [11]func(rune) int
-fun[0] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
+  case 65: return -1
   case 68: return -1
-  case 82: return -1
+  case 69: return 9
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return -1
   case 101: return -1
-  case 114: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 68: return -1
   case 69: return -1
-  case 111: return 1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
   case 100: return -1
-  case 79: return 2
+  case 101: return 10
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1191,16 +1281,20 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+fun[9] = func(r rune) int {
   switch(r) {
-  case 68: return 4
-  case 82: return -1
-  case 101: return -1
-  case 114: return -1
+  case 65: return -1
+  case 68: return 11
   case 69: return -1
-  case 111: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
   case 100: return -1
-  case 79: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1208,17 +1302,42 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[6] = true
-fun[6] = func(r rune) int {
+fun[10] = func(r rune) int {
   switch(r) {
+  case 65: return -1
   case 68: return -1
-  case 82: return -1
+  case 69: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
+  case 100: return 12
   case 101: return -1
-  case 114: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[11] = true
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 68: return -1
   case 69: return -1
-  case 111: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
   case 100: return -1
-  case 79: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
   default:
     switch {
     default: return -1
@@ -1226,16 +1345,5007 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+acc[12] = true
+fun[12] = func(r rune) int {
   switch(r) {
+  case 65: return -1
   case 68: return -1
-  case 82: return -1
-  case 101: return -1
-  case 114: return 7
   case 69: return -1
-  case 111: return -1
+  case 76: return -1
+  case 85: return -1
+  case 86: return -1
+  case 97: return -1
   case 100: return -1
-  case 79: return -1
+  case 101: return -1
+  case 108: return -1
+  case 117: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[8].acc = acc[:]
+a0[8].f = fun[:]
+a0[8].id = 8
+}
+{
+var acc [15]bool
+var fun [15]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return 1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return 2
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return 3
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return 5
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return 6
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return 7
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return 8
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 65: return 9
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return 10
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return 11
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return 12
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return 13
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return 14
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[13] = true
+fun[13] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[14] = true
+fun[14] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 73: return -1
+  case 76: return -1
+  case 78: return -1
+  case 80: return -1
+  case 88: return -1
+  case 97: return -1
+  case 101: return -1
+  case 105: return -1
+  case 108: return -1
+  case 110: return -1
+  case 112: return -1
+  case 120: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[9].acc = acc[:]
+a0[9].f = fun[:]
+a0[9].id = 9
+}
+{
+var acc [13]bool
+var fun [13]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return 1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return 2
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return 3
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return 4
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return 5
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return 6
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return 7
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return 8
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 67: return 9
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return 10
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return 11
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return 12
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[11] = true
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[12] = true
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[10].acc = acc[:]
+a0[10].f = fun[:]
+a0[10].id = 10
+}
+{
+var acc [5]bool
+var fun [5]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return 1
+  case 83: return -1
+  case 97: return 2
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 83: return 3
+  case 97: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 83: return -1
+  case 97: return -1
+  case 115: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[3] = true
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 83: return -1
+  case 97: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[4] = true
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 83: return -1
+  case 97: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[11].acc = acc[:]
+a0[11].f = fun[:]
+a0[11].id = 11
+}
+{
+var acc [17]bool
+var fun [17]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return 1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return 2
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return 3
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return 4
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return 5
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return 6
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return 7
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return 8
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return 9
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return 10
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return 11
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return 12
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 67: return 13
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return 14
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[13] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return 15
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[14] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return 16
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[15] = true
+fun[15] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[16] = true
+fun[16] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 73: return -1
+  case 78: return -1
+  case 83: return -1
+  case 84: return -1
+  case 99: return -1
+  case 100: return -1
+  case 105: return -1
+  case 110: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[12].acc = acc[:]
+a0[12].f = fun[:]
+a0[12].id = 12
+}
+{
+var acc [13]bool
+var fun [13]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return 1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return 2
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return 3
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return 4
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return 5
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return 6
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return 7
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return 8
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return 9
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return 10
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 69: return 11
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return 12
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[11] = true
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[12] = true
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 78: return -1
+  case 81: return -1
+  case 85: return -1
+  case 101: return -1
+  case 105: return -1
+  case 110: return -1
+  case 113: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[13].acc = acc[:]
+a0[13].f = fun[:]
+a0[13].id = 13
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 70: return 1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return 2
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return 3
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return 5
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return 6
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return 7
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return 8
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 77: return -1
+  case 79: return -1
+  case 82: return -1
+  case 102: return -1
+  case 109: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[14].acc = acc[:]
+a0[14].f = fun[:]
+a0[14].id = 14
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return 1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return 2
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return 3
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return 5
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return 6
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return 7
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return 8
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 86: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[15].acc = acc[:]
+a0[15].f = fun[:]
+a0[15].id = 15
+}
+{
+var acc [7]bool
+var fun [7]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return 1
+  case 78: return -1
+  case 89: return -1
+  case 97: return 2
+  case 110: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return 3
+  case 89: return -1
+  case 97: return -1
+  case 110: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return -1
+  case 89: return -1
+  case 97: return -1
+  case 110: return 4
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return -1
+  case 89: return 5
+  case 97: return -1
+  case 110: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return -1
+  case 89: return -1
+  case 97: return -1
+  case 110: return -1
+  case 121: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[5] = true
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return -1
+  case 89: return -1
+  case 97: return -1
+  case 110: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[6] = true
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 78: return -1
+  case 89: return -1
+  case 97: return -1
+  case 110: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[16].acc = acc[:]
+a0[16].f = fun[:]
+a0[16].id = 16
+}
+{
+var acc [7]bool
+var fun [7]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return 1
+  case 76: return -1
+  case 97: return 2
+  case 108: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return 3
+  case 97: return -1
+  case 108: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return -1
+  case 97: return -1
+  case 108: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return 5
+  case 97: return -1
+  case 108: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return -1
+  case 97: return -1
+  case 108: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[5] = true
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return -1
+  case 97: return -1
+  case 108: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[6] = true
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 76: return -1
+  case 97: return -1
+  case 108: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[17].acc = acc[:]
+a0[17].f = fun[:]
+a0[17].id = 17
+}
+{
+var acc [5]bool
+var fun [5]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 73: return 1
+  case 78: return -1
+  case 105: return 2
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 78: return 3
+  case 105: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 78: return -1
+  case 105: return -1
+  case 110: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[3] = true
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 78: return -1
+  case 105: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[4] = true
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 78: return -1
+  case 105: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[18].acc = acc[:]
+a0[18].f = fun[:]
+a0[18].id = 18
+}
+{
+var acc [19]bool
+var fun [19]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return 1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return 2
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return 3
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return 4
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return 5
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return 7
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return 8
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return 9
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return 10
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return 11
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return 12
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return 13
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return 14
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[13] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return 15
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[14] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return 16
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[15] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return 17
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[16] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return 18
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[17] = true
+fun[17] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[18] = true
+fun[18] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 69: return -1
+  case 70: return -1
+  case 73: return -1
+  case 83: return -1
+  case 84: return -1
+  case 97: return -1
+  case 101: return -1
+  case 102: return -1
+  case 105: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[19].acc = acc[:]
+a0[19].f = fun[:]
+a0[19].id = 19
+}
+{
+var acc [7]bool
+var fun [7]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return 1
+  case 78: return -1
+  case 100: return -1
+  case 101: return 2
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 78: return 3
+  case 100: return -1
+  case 101: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 78: return -1
+  case 100: return -1
+  case 101: return -1
+  case 110: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 68: return 5
+  case 69: return -1
+  case 78: return -1
+  case 100: return -1
+  case 101: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 78: return -1
+  case 100: return 6
+  case 101: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[5] = true
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 78: return -1
+  case 100: return -1
+  case 101: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[6] = true
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 78: return -1
+  case 100: return -1
+  case 101: return -1
+  case 110: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[20].acc = acc[:]
+a0[20].f = fun[:]
+a0[20].id = 20
+}
+{
+var acc [11]bool
+var fun [11]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 70: return 1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return 2
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return 3
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return 4
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return 5
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return 6
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return 7
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return 8
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return 9
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return 10
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[9] = true
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[10] = true
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 82: return -1
+  case 83: return -1
+  case 84: return -1
+  case 102: return -1
+  case 105: return -1
+  case 114: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[21].acc = acc[:]
+a0[21].f = fun[:]
+a0[21].id = 21
+}
+{
+var acc [5]bool
+var fun [5]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return 1
+  case 102: return -1
+  case 105: return 2
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 70: return 3
+  case 73: return -1
+  case 102: return -1
+  case 105: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 102: return 4
+  case 105: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[3] = true
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 102: return -1
+  case 105: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[4] = true
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 70: return -1
+  case 73: return -1
+  case 102: return -1
+  case 105: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[22].acc = acc[:]
+a0[22].f = fun[:]
+a0[22].id = 22
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return 1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return 2
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return 3
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return 4
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return 5
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return 7
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return 8
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 69: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[23].acc = acc[:]
+a0[23].f = fun[:]
+a0[23].id = 23
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return 1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return 2
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return 3
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return 4
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return 5
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return 6
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return 7
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return 8
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[24].acc = acc[:]
+a0[24].f = fun[:]
+a0[24].id = 24
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return 1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return 2
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return 3
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return 4
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return 5
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return 6
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return 7
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return 8
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 78: return -1
+  case 84: return -1
+  case 101: return -1
+  case 104: return -1
+  case 110: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[25].acc = acc[:]
+a0[25].f = fun[:]
+a0[25].id = 25
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return 1
+  case 76: return -1
+  case 83: return -1
+  case 101: return 2
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return 3
+  case 83: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 101: return -1
+  case 108: return 4
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return 5
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return 7
+  case 76: return -1
+  case 83: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 101: return 8
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 76: return -1
+  case 83: return -1
+  case 101: return -1
+  case 108: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[26].acc = acc[:]
+a0[26].f = fun[:]
+a0[26].id = 26
+}
+{
+var acc [11]bool
+var fun [11]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return 1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return 2
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return 3
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return 4
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return 5
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return 6
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return 7
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return 8
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return 9
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return 10
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[9] = true
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[10] = true
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 72: return -1
+  case 82: return -1
+  case 87: return -1
+  case 101: return -1
+  case 104: return -1
+  case 114: return -1
+  case 119: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[27].acc = acc[:]
+a0[27].f = fun[:]
+a0[27].id = 27
+}
+{
+var acc [11]bool
+var fun [11]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 71: return 1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return 2
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return 3
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return 4
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return 5
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return 6
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return 7
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return 8
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return 9
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return 10
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[9] = true
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[10] = true
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 71: return -1
+  case 79: return -1
+  case 80: return -1
+  case 82: return -1
+  case 85: return -1
+  case 103: return -1
+  case 111: return -1
+  case 112: return -1
+  case 114: return -1
+  case 117: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[28].acc = acc[:]
+a0[28].f = fun[:]
+a0[28].id = 28
+}
+{
+var acc [13]bool
+var fun [13]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return 1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return 2
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return 3
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return 4
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return 5
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return 7
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return 8
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return 9
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return 10
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return 11
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return 12
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[11] = true
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[12] = true
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 71: return -1
+  case 72: return -1
+  case 73: return -1
+  case 78: return -1
+  case 86: return -1
+  case 97: return -1
+  case 103: return -1
+  case 104: return -1
+  case 105: return -1
+  case 110: return -1
+  case 118: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[29].acc = acc[:]
+a0[29].f = fun[:]
+a0[29].id = 29
+}
+{
+var acc [11]bool
+var fun [11]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return 1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return 2
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return 3
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 68: return 5
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return 6
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return 7
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return 8
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return 9
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return 10
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[9] = true
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[10] = true
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 68: return -1
+  case 69: return -1
+  case 79: return -1
+  case 82: return -1
+  case 100: return -1
+  case 101: return -1
+  case 111: return -1
+  case 114: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[30].acc = acc[:]
+a0[30].f = fun[:]
+a0[30].id = 30
+}
+{
+var acc [5]bool
+var fun [5]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 66: return 1
+  case 89: return -1
+  case 98: return 2
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 66: return -1
+  case 89: return 3
+  case 98: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 66: return -1
+  case 89: return -1
+  case 98: return -1
+  case 121: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[3] = true
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 66: return -1
+  case 89: return -1
+  case 98: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[4] = true
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 66: return -1
+  case 89: return -1
+  case 98: return -1
+  case 121: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[31].acc = acc[:]
+a0[31].f = fun[:]
+a0[31].id = 31
+}
+{
+var acc [7]bool
+var fun [7]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 65: return 1
+  case 67: return -1
+  case 83: return -1
+  case 97: return 2
+  case 99: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 83: return 3
+  case 97: return -1
+  case 99: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 115: return 4
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return 5
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return 6
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[5] = true
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[6] = true
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 65: return -1
+  case 67: return -1
+  case 83: return -1
+  case 97: return -1
+  case 99: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[32].acc = acc[:]
+a0[32].f = fun[:]
+a0[32].id = 32
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return 1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return 2
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return 3
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return 4
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return 5
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return 6
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 67: return 7
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return 8
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[7] = true
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[8] = true
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 67: return -1
+  case 68: return -1
+  case 69: return -1
+  case 83: return -1
+  case 99: return -1
+  case 100: return -1
+  case 101: return -1
+  case 115: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[33].acc = acc[:]
+a0[33].f = fun[:]
+a0[33].id = 33
+}
+{
+var acc [13]bool
+var fun [13]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return 1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return 2
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return 3
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return 4
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return 5
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return 6
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return 7
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return 8
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[7] = func(r rune) int {
+  switch(r) {
+  case 69: return 9
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[8] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return 10
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[9] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return 11
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[10] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return 12
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[11] = true
+fun[11] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[12] = true
+fun[12] = func(r rune) int {
+  switch(r) {
+  case 69: return -1
+  case 70: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 101: return -1
+  case 102: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+a0[34].acc = acc[:]
+a0[34].f = fun[:]
+a0[34].id = 34
+}
+{
+var acc [11]bool
+var fun [11]func(rune) int
+fun[0] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 76: return 1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return 2
+  case 109: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[1] = func(r rune) int {
+  switch(r) {
+  case 73: return 3
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[2] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return 4
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[3] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 76: return -1
+  case 77: return 5
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[4] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return 6
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[5] = func(r rune) int {
+  switch(r) {
+  case 73: return 7
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
+  default:
+    switch {
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+fun[6] = func(r rune) int {
+  switch(r) {
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return 8
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -1243,16 +6353,16 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
-  case 68: return -1
-  case 82: return -1
-  case 101: return -1
-  case 114: return -1
-  case 69: return 5
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return 9
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -1262,14 +6372,14 @@ fun[4] = func(r rune) int {
 }
 fun[8] = func(r rune) int {
   switch(r) {
-  case 114: return -1
-  case 69: return -1
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
-  case 68: return -1
-  case 82: return -1
-  case 101: return 9
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return 10
   default:
     switch {
     default: return -1
@@ -1277,16 +6387,17 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
+acc[9] = true
 fun[9] = func(r rune) int {
   switch(r) {
-  case 68: return -1
-  case 82: return -1
-  case 101: return -1
-  case 114: return 10
-  case 69: return -1
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -1294,16 +6405,17 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+acc[10] = true
+fun[10] = func(r rune) int {
   switch(r) {
-  case 68: return -1
-  case 82: return 6
-  case 101: return -1
-  case 114: return -1
-  case 69: return -1
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
+  case 73: return -1
+  case 76: return -1
+  case 77: return -1
+  case 84: return -1
+  case 105: return -1
+  case 108: return -1
+  case 109: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -1311,16 +6423,16 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+a0[35].acc = acc[:]
+a0[35].f = fun[:]
+a0[35].id = 35
+}
+{
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 68: return -1
-  case 82: return 3
-  case 101: return -1
-  case 114: return -1
-  case 69: return -1
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
+  case 43: return 1
   default:
     switch {
     default: return -1
@@ -1328,16 +6440,10 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[7] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 100: return 8
-  case 79: return -1
-  case 68: return -1
-  case 82: return -1
-  case 101: return -1
-  case 114: return -1
-  case 69: return -1
-  case 111: return -1
+  case 43: return -1
   default:
     switch {
     default: return -1
@@ -1345,17 +6451,16 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[10] = true
-fun[10] = func(r rune) int {
+a0[36].acc = acc[:]
+a0[36].f = fun[:]
+a0[36].id = 36
+}
+{
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 100: return -1
-  case 79: return -1
-  case 68: return -1
-  case 82: return -1
-  case 101: return -1
-  case 114: return -1
-  case 69: return -1
+  case 45: return 1
   default:
     switch {
     default: return -1
@@ -1363,19 +6468,10 @@ fun[10] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[9].acc = acc[:]
-a0[9].f = fun[:]
-a0[9].id = 9
-}
-{
-var acc [5]bool
-var fun [5]func(rune) int
-fun[2] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 98: return -1
-  case 66: return -1
-  case 121: return -1
-  case 89: return 3
+  case 45: return -1
   default:
     switch {
     default: return -1
@@ -1383,13 +6479,16 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[3] = true
-fun[3] = func(r rune) int {
+a0[37].acc = acc[:]
+a0[37].f = fun[:]
+a0[37].id = 37
+}
+{
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 98: return -1
-  case 66: return -1
-  case 121: return -1
-  case 89: return -1
+  case 42: return 1
   default:
     switch {
     default: return -1
@@ -1397,12 +6496,10 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 98: return 1
-  case 66: return 2
-  case 121: return -1
-  case 89: return -1
+  case 42: return -1
   default:
     switch {
     default: return -1
@@ -1410,12 +6507,16 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+a0[38].acc = acc[:]
+a0[38].f = fun[:]
+a0[38].id = 38
+}
+{
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 66: return -1
-  case 121: return 4
-  case 89: return -1
-  case 98: return -1
+  case 47: return 1
   default:
     switch {
     default: return -1
@@ -1423,13 +6524,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[4] = true
-fun[4] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 98: return -1
-  case 66: return -1
-  case 121: return -1
-  case 89: return -1
+  case 47: return -1
   default:
     switch {
     default: return -1
@@ -1437,21 +6535,16 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[10].acc = acc[:]
-a0[10].f = fun[:]
-a0[10].id = 10
+a0[39].acc = acc[:]
+a0[39].f = fun[:]
+a0[39].id = 39
 }
 {
-var acc [7]bool
-var fun [7]func(rune) int
-fun[1] = func(r rune) int {
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 67: return -1
-  case 65: return -1
-  case 97: return -1
-  case 83: return 5
-  case 99: return -1
+  case 37: return 1
   default:
     switch {
     default: return -1
@@ -1459,15 +6552,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[6] = true
-fun[6] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 65: return -1
-  case 97: return -1
-  case 83: return -1
-  case 99: return -1
-  case 115: return -1
-  case 67: return -1
+  case 37: return -1
   default:
     switch {
     default: return -1
@@ -1475,15 +6563,16 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[4] = true
-fun[4] = func(r rune) int {
+a0[40].acc = acc[:]
+a0[40].f = fun[:]
+a0[40].id = 40
+}
+{
+var acc [3]bool
+var fun [3]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 67: return -1
-  case 65: return -1
-  case 97: return -1
-  case 83: return -1
-  case 99: return -1
+  case 124: return 1
   default:
     switch {
     default: return -1
@@ -1491,14 +6580,9 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 65: return 1
-  case 97: return 2
-  case 83: return -1
-  case 99: return -1
-  case 115: return -1
-  case 67: return -1
+  case 124: return 2
   default:
     switch {
     default: return -1
@@ -1506,14 +6590,10 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
+acc[2] = true
 fun[2] = func(r rune) int {
   switch(r) {
-  case 97: return -1
-  case 83: return -1
-  case 99: return -1
-  case 115: return 3
-  case 67: return -1
-  case 65: return -1
+  case 124: return -1
   default:
     switch {
     default: return -1
@@ -1521,14 +6601,16 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+a0[41].acc = acc[:]
+a0[41].f = fun[:]
+a0[41].id = 41
+}
+{
+var acc [2]bool
+var fun [2]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 67: return -1
-  case 65: return -1
-  case 97: return -1
-  case 83: return -1
-  case 99: return 4
+  case 61: return 1
   default:
     switch {
     default: return -1
@@ -1536,14 +6618,10 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 97: return -1
-  case 83: return -1
-  case 99: return -1
-  case 115: return -1
-  case 67: return 6
-  case 65: return -1
+  case 61: return -1
   default:
     switch {
     default: return -1
@@ -1551,23 +6629,21 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[11].acc = acc[:]
-a0[11].f = fun[:]
-a0[11].id = 11
+a0[42].acc = acc[:]
+a0[42].f = fun[:]
+a0[42].id = 42
 }
 {
-var acc [9]bool
-var fun [9]func(rune) int
-fun[3] = func(r rune) int {
+var acc [7]bool
+var fun [7]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 100: return -1
-  case 67: return -1
-  case 83: return -1
-  case 115: return 4
-  case 99: return -1
-  case 101: return -1
-  case 69: return -1
+  case 65: return 1
   case 68: return -1
+  case 78: return -1
+  case 97: return 2
+  case 100: return -1
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1575,16 +6651,14 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 115: return -1
-  case 99: return 5
-  case 101: return -1
-  case 69: return -1
+  case 65: return -1
   case 68: return -1
+  case 78: return 3
+  case 97: return -1
   case 100: return -1
-  case 67: return -1
-  case 83: return -1
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1592,16 +6666,14 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 69: return 6
+  case 65: return -1
   case 68: return -1
+  case 78: return -1
+  case 97: return -1
   case 100: return -1
-  case 67: return -1
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
+  case 110: return 4
   default:
     switch {
     default: return -1
@@ -1609,16 +6681,14 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 67: return -1
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
-  case 69: return -1
-  case 68: return 1
-  case 100: return 2
+  case 65: return -1
+  case 68: return 5
+  case 78: return -1
+  case 97: return -1
+  case 100: return -1
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1626,16 +6696,14 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[6] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 69: return -1
+  case 65: return -1
   case 68: return -1
-  case 100: return -1
-  case 67: return -1
-  case 83: return 7
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
+  case 78: return -1
+  case 97: return -1
+  case 100: return 6
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1646,14 +6714,12 @@ fun[6] = func(r rune) int {
 acc[5] = true
 fun[5] = func(r rune) int {
   switch(r) {
-  case 69: return -1
+  case 65: return -1
   case 68: return -1
+  case 78: return -1
+  case 97: return -1
   case 100: return -1
-  case 67: return -1
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1661,17 +6727,15 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[8] = true
-fun[8] = func(r rune) int {
+acc[6] = true
+fun[6] = func(r rune) int {
   switch(r) {
-  case 69: return -1
+  case 65: return -1
   case 68: return -1
+  case 78: return -1
+  case 97: return -1
   case 100: return -1
-  case 67: return -1
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
+  case 110: return -1
   default:
     switch {
     default: return -1
@@ -1679,33 +6743,19 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
-  switch(r) {
-  case 69: return -1
-  case 68: return -1
-  case 100: return -1
-  case 67: return -1
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return 3
-  default:
-    switch {
-    default: return -1
-    }
-  }
-  panic("unreachable")
+a0[43].acc = acc[:]
+a0[43].f = fun[:]
+a0[43].id = 43
 }
-fun[7] = func(r rune) int {
+{
+var acc [5]bool
+var fun [5]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 69: return -1
-  case 68: return -1
-  case 100: return -1
-  case 67: return 8
-  case 83: return -1
-  case 115: return -1
-  case 99: return -1
-  case 101: return -1
+  case 79: return 1
+  case 82: return -1
+  case 111: return 2
+  case 114: return -1
   default:
     switch {
     default: return -1
@@ -1713,25 +6763,12 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[12].acc = acc[:]
-a0[12].f = fun[:]
-a0[12].id = 12
-}
-{
-var acc [13]bool
-var fun [13]func(rune) int
 fun[1] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
-  case 69: return -1
-  case 102: return 8
-  case 83: return -1
   case 79: return -1
-  case 116: return -1
+  case 82: return 3
+  case 111: return -1
+  case 114: return -1
   default:
     switch {
     default: return -1
@@ -1739,19 +6776,12 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[12] = true
-fun[12] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
-  case 69: return -1
-  case 102: return -1
-  case 83: return -1
   case 79: return -1
-  case 116: return -1
+  case 82: return -1
+  case 111: return -1
+  case 114: return 4
   default:
     switch {
     default: return -1
@@ -1759,19 +6789,13 @@ fun[12] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[7] = true
-fun[7] = func(r rune) int {
+acc[3] = true
+fun[3] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
-  case 69: return -1
-  case 102: return -1
-  case 83: return -1
   case 79: return -1
-  case 116: return -1
+  case 82: return -1
+  case 111: return -1
+  case 114: return -1
   default:
     switch {
     default: return -1
@@ -1779,18 +6803,13 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[9] = func(r rune) int {
+acc[4] = true
+fun[4] = func(r rune) int {
   switch(r) {
-  case 84: return -1
-  case 101: return -1
-  case 115: return 10
-  case 70: return -1
-  case 69: return -1
-  case 102: return -1
-  case 83: return -1
   case 79: return -1
-  case 116: return -1
+  case 82: return -1
   case 111: return -1
+  case 114: return -1
   default:
     switch {
     default: return -1
@@ -1798,18 +6817,23 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+a0[44].acc = acc[:]
+a0[44].f = fun[:]
+a0[44].id = 44
+}
+{
+var acc [9]bool
+var fun [9]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return 4
   case 69: return -1
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
-  case 116: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return 1
+  case 101: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return 2
   default:
     switch {
     default: return -1
@@ -1817,18 +6841,16 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[10] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 111: return -1
-  case 84: return -1
-  case 101: return 11
-  case 115: return -1
-  case 70: return -1
   case 69: return -1
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
-  case 116: return -1
+  case 73: return 3
+  case 75: return -1
+  case 76: return -1
+  case 101: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1838,16 +6860,14 @@ fun[10] = func(r rune) int {
 }
 fun[2] = func(r rune) int {
   switch(r) {
-  case 116: return -1
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return 3
   case 69: return -1
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
+  case 101: return -1
+  case 105: return 4
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1855,18 +6875,16 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
+  case 69: return -1
+  case 73: return -1
+  case 75: return 5
+  case 76: return -1
   case 101: return -1
-  case 115: return -1
-  case 70: return -1
-  case 69: return 6
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
-  case 116: return -1
-  case 111: return -1
-  case 84: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1874,18 +6892,16 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
-  switch(r) {
-  case 111: return 1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
+fun[4] = func(r rune) int {
+  switch(r) {
   case 69: return -1
-  case 102: return -1
-  case 83: return -1
-  case 79: return 2
-  case 116: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
+  case 101: return -1
+  case 105: return -1
+  case 107: return 6
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1893,18 +6909,16 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[6] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
-  case 116: return -1
-  case 111: return -1
-  case 84: return 7
+  case 69: return 7
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
   case 101: return -1
-  case 115: return -1
-  case 70: return -1
-  case 69: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1912,18 +6926,16 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[11] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 116: return 12
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
   case 69: return -1
-  case 102: return -1
-  case 83: return -1
-  case 79: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
+  case 101: return 8
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1931,18 +6943,17 @@ fun[11] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[8] = func(r rune) int {
+acc[7] = true
+fun[7] = func(r rune) int {
   switch(r) {
-  case 102: return 9
-  case 83: return -1
-  case 79: return -1
-  case 116: return -1
-  case 111: return -1
-  case 84: return -1
-  case 101: return -1
-  case 115: return -1
-  case 70: return -1
   case 69: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
+  case 101: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1950,18 +6961,17 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[4] = func(r rune) int {
+acc[8] = true
+fun[8] = func(r rune) int {
   switch(r) {
   case 69: return -1
-  case 102: return -1
-  case 83: return 5
-  case 79: return -1
-  case 116: return -1
-  case 111: return -1
-  case 84: return -1
+  case 73: return -1
+  case 75: return -1
+  case 76: return -1
   case 101: return -1
-  case 115: return -1
-  case 70: return -1
+  case 105: return -1
+  case 107: return -1
+  case 108: return -1
   default:
     switch {
     default: return -1
@@ -1969,23 +6979,25 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[13].acc = acc[:]
-a0[13].f = fun[:]
-a0[13].id = 13
+a0[45].acc = acc[:]
+a0[45].f = fun[:]
+a0[45].id = 45
 }
 {
-var acc [11]bool
-var fun [11]func(rune) int
+var acc [15]bool
+var fun [15]func(rune) int
 fun[0] = func(r rune) int {
   switch(r) {
+  case 66: return 1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return 1
+  case 87: return -1
+  case 98: return 2
+  case 101: return -1
+  case 110: return -1
   case 116: return -1
-  case 105: return -1
-  case 109: return -1
-  case 76: return 2
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -1993,16 +7005,18 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[7] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 105: return -1
-  case 109: return 8
-  case 76: return -1
+  case 66: return -1
+  case 69: return 3
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
   case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2010,16 +7024,18 @@ fun[7] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 105: return -1
-  case 109: return -1
-  case 76: return -1
-  case 84: return 6
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
+  case 84: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return 4
+  case 110: return -1
   case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2029,14 +7045,16 @@ fun[5] = func(r rune) int {
 }
 fun[3] = func(r rune) int {
   switch(r) {
-  case 108: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
+  case 84: return 5
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
   case 116: return -1
-  case 105: return -1
-  case 109: return -1
-  case 76: return -1
-  case 84: return -1
-  case 77: return 4
-  case 73: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2046,14 +7064,16 @@ fun[3] = func(r rune) int {
 }
 fun[4] = func(r rune) int {
   switch(r) {
-  case 76: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return 5
-  case 108: return -1
-  case 116: return -1
-  case 105: return -1
-  case 109: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return 6
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2061,16 +7081,18 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[9] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 109: return -1
-  case 76: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
-  case 116: return 10
-  case 105: return -1
+  case 87: return 7
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2078,16 +7100,18 @@ fun[9] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 105: return -1
-  case 109: return -1
-  case 76: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return 3
-  case 108: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
   case 116: return -1
+  case 119: return 8
   default:
     switch {
     default: return -1
@@ -2095,17 +7119,18 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[10] = true
-fun[10] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
-  case 108: return -1
-  case 116: return -1
-  case 105: return -1
-  case 109: return -1
-  case 76: return -1
+  case 66: return -1
+  case 69: return 9
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2113,17 +7138,18 @@ fun[10] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[6] = true
-fun[6] = func(r rune) int {
+fun[8] = func(r rune) int {
   switch(r) {
-  case 105: return -1
-  case 109: return -1
-  case 76: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return 10
+  case 110: return -1
   case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2131,16 +7157,18 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[8] = func(r rune) int {
+fun[9] = func(r rune) int {
   switch(r) {
-  case 105: return 9
-  case 109: return -1
-  case 76: return -1
+  case 66: return -1
+  case 69: return 11
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
   case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2148,16 +7176,18 @@ fun[8] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[10] = func(r rune) int {
   switch(r) {
-  case 76: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
   case 84: return -1
-  case 77: return -1
-  case 73: return -1
-  case 108: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return 12
+  case 110: return -1
   case 116: return -1
-  case 105: return 7
-  case 109: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2165,16 +7195,18 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[14].acc = acc[:]
-a0[14].f = fun[:]
-a0[14].id = 14
-}
-{
-var acc [2]bool
-var fun [2]func(rune) int
-fun[0] = func(r rune) int {
+fun[11] = func(r rune) int {
   switch(r) {
-  case 43: return 1
+  case 66: return -1
+  case 69: return -1
+  case 78: return 13
+  case 84: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2182,10 +7214,18 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[12] = func(r rune) int {
   switch(r) {
-  case 43: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
+  case 84: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return 14
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2193,17 +7233,19 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[15].acc = acc[:]
-a0[15].f = fun[:]
-a0[15].id = 15
-}
-{
-var acc [2]bool
-var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+acc[13] = true
+fun[13] = func(r rune) int {
   switch(r) {
-  case 45: return -1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
+  case 84: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2211,9 +7253,19 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[14] = true
+fun[14] = func(r rune) int {
   switch(r) {
-  case 45: return 1
+  case 66: return -1
+  case 69: return -1
+  case 78: return -1
+  case 84: return -1
+  case 87: return -1
+  case 98: return -1
+  case 101: return -1
+  case 110: return -1
+  case 116: return -1
+  case 119: return -1
   default:
     switch {
     default: return -1
@@ -2221,27 +7273,19 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[16].acc = acc[:]
-a0[16].f = fun[:]
-a0[16].id = 16
+a0[46].acc = acc[:]
+a0[46].f = fun[:]
+a0[46].id = 46
 }
 {
-var acc [2]bool
-var fun [2]func(rune) int
+var acc [5]bool
+var fun [5]func(rune) int
 fun[0] = func(r rune) int {
   switch(r) {
-  case 42: return 1
-  default:
-    switch {
-    default: return -1
-    }
-  }
-  panic("unreachable")
-}
-acc[1] = true
-fun[1] = func(r rune) int {
-  switch(r) {
-  case 42: return -1
+  case 73: return 1
+  case 83: return -1
+  case 105: return 2
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -2249,17 +7293,12 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[17].acc = acc[:]
-a0[17].f = fun[:]
-a0[17].id = 17
-}
-{
-var acc [2]bool
-var fun [2]func(rune) int
-acc[1] = true
 fun[1] = func(r rune) int {
   switch(r) {
-  case 47: return -1
+  case 73: return -1
+  case 83: return 3
+  case 105: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -2267,9 +7306,12 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 47: return 1
+  case 73: return -1
+  case 83: return -1
+  case 105: return -1
+  case 115: return 4
   default:
     switch {
     default: return -1
@@ -2277,17 +7319,13 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[18].acc = acc[:]
-a0[18].f = fun[:]
-a0[18].id = 18
-}
-{
-var acc [2]bool
-var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+acc[3] = true
+fun[3] = func(r rune) int {
   switch(r) {
-  case 61: return -1
+  case 73: return -1
+  case 83: return -1
+  case 105: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -2295,9 +7333,13 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[4] = true
+fun[4] = func(r rune) int {
   switch(r) {
-  case 61: return 1
+  case 73: return -1
+  case 83: return -1
+  case 105: return -1
+  case 115: return -1
   default:
     switch {
     default: return -1
@@ -2305,22 +7347,28 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[19].acc = acc[:]
-a0[19].f = fun[:]
-a0[19].id = 19
+a0[47].acc = acc[:]
+a0[47].f = fun[:]
+a0[47].id = 47
 }
 {
-var acc [7]bool
-var fun [7]func(rune) int
-acc[6] = true
-fun[6] = func(r rune) int {
+var acc [12]bool
+var fun [12]func(rune) int
+fun[0] = func(r rune) int {
   switch(r) {
-  case 65: return -1
-  case 68: return -1
-  case 110: return -1
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return 1
   case 78: return -1
-  case 97: return -1
-  case 100: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return 2
+  case 110: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2328,14 +7376,21 @@ fun[6] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[5] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
   case 78: return -1
-  case 97: return -1
-  case 100: return 6
-  case 65: return -1
-  case 68: return -1
+  case 79: return -1
+  case 83: return 3
+  case 84: return -1
+  case 105: return -1
   case 110: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2343,15 +7398,21 @@ fun[5] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[4] = true
-fun[4] = func(r rune) int {
+fun[2] = func(r rune) int {
   switch(r) {
-  case 110: return -1
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
   case 78: return -1
-  case 97: return -1
-  case 100: return -1
-  case 65: return -1
-  case 68: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
+  case 111: return -1
+  case 115: return 4
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2359,14 +7420,21 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[3] = func(r rune) int {
   switch(r) {
-  case 100: return -1
-  case 65: return -1
-  case 68: return -1
-  case 110: return 5
+  case 9: return 5
+  case 10: return 5
+  case 32: return 5
+  case 73: return -1
   case 78: return -1
-  case 97: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2374,14 +7442,21 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[4] = func(r rune) int {
   switch(r) {
-  case 97: return 1
-  case 100: return -1
-  case 65: return 2
-  case 68: return -1
-  case 110: return -1
+  case 9: return 5
+  case 10: return 5
+  case 32: return 5
+  case 73: return -1
   case 78: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2389,14 +7464,21 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[3] = func(r rune) int {
+fun[5] = func(r rune) int {
   switch(r) {
-  case 78: return -1
-  case 97: return -1
-  case 100: return -1
-  case 65: return -1
-  case 68: return 4
-  case 110: return -1
+  case 9: return 5
+  case 10: return 5
+  case 32: return 5
+  case 73: return -1
+  case 78: return 6
+  case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return 7
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2404,14 +7486,21 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+fun[6] = func(r rune) int {
   switch(r) {
-  case 68: return -1
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
+  case 79: return 8
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
   case 110: return -1
-  case 78: return 3
-  case 97: return -1
-  case 100: return -1
-  case 65: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2419,20 +7508,21 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[20].acc = acc[:]
-a0[20].f = fun[:]
-a0[20].id = 20
-}
-{
-var acc [5]bool
-var fun [5]func(rune) int
-acc[3] = true
-fun[3] = func(r rune) int {
+fun[7] = func(r rune) int {
   switch(r) {
-  case 114: return -1
-  case 111: return -1
-  case 82: return -1
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
   case 79: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
+  case 111: return 9
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2440,12 +7530,21 @@ fun[3] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[8] = func(r rune) int {
   switch(r) {
-  case 79: return 1
-  case 114: return -1
-  case 111: return 2
-  case 82: return -1
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
+  case 79: return -1
+  case 83: return -1
+  case 84: return 10
+  case 105: return -1
+  case 110: return -1
+  case 111: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2453,12 +7552,21 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+fun[9] = func(r rune) int {
   switch(r) {
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
   case 79: return -1
-  case 114: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
   case 111: return -1
-  case 82: return 4
+  case 115: return -1
+  case 116: return 11
   default:
     switch {
     default: return -1
@@ -2466,12 +7574,22 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[2] = func(r rune) int {
+acc[10] = true
+fun[10] = func(r rune) int {
   switch(r) {
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
   case 79: return -1
-  case 114: return 3
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
   case 111: return -1
-  case 82: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2479,13 +7597,22 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[4] = true
-fun[4] = func(r rune) int {
+acc[11] = true
+fun[11] = func(r rune) int {
   switch(r) {
+  case 9: return -1
+  case 10: return -1
+  case 32: return -1
+  case 73: return -1
+  case 78: return -1
   case 79: return -1
-  case 114: return -1
+  case 83: return -1
+  case 84: return -1
+  case 105: return -1
+  case 110: return -1
   case 111: return -1
-  case 82: return -1
+  case 115: return -1
+  case 116: return -1
   default:
     switch {
     default: return -1
@@ -2493,17 +7620,16 @@ fun[4] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[21].acc = acc[:]
-a0[21].f = fun[:]
-a0[21].id = 21
+a0[48].acc = acc[:]
+a0[48].f = fun[:]
+a0[48].id = 48
 }
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 33: return -1
+  case 33: return 1
   default:
     switch {
     default: return -1
@@ -2511,9 +7637,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 33: return 1
+  case 33: return -1
   default:
     switch {
     default: return -1
@@ -2521,17 +7648,16 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[22].acc = acc[:]
-a0[22].f = fun[:]
-a0[22].id = 22
+a0[49].acc = acc[:]
+a0[49].f = fun[:]
+a0[49].id = 49
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 33: return -1
+  case 33: return 1
   case 61: return -1
   default:
     switch {
@@ -2551,10 +7677,11 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
+  case 33: return -1
   case 61: return -1
-  case 33: return 1
   default:
     switch {
     default: return -1
@@ -2562,17 +7689,16 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[23].acc = acc[:]
-a0[23].f = fun[:]
-a0[23].id = 23
+a0[50].acc = acc[:]
+a0[50].f = fun[:]
+a0[50].id = 50
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 60: return -1
+  case 60: return 1
   case 62: return -1
   default:
     switch {
@@ -2581,10 +7707,10 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 60: return 1
-  case 62: return -1
+  case 60: return -1
+  case 62: return 2
   default:
     switch {
     default: return -1
@@ -2592,10 +7718,11 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
   case 60: return -1
-  case 62: return 2
+  case 62: return -1
   default:
     switch {
     default: return -1
@@ -2603,9 +7730,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[24].acc = acc[:]
-a0[24].f = fun[:]
-a0[24].id = 24
+a0[51].acc = acc[:]
+a0[51].f = fun[:]
+a0[51].id = 51
 }
 {
 var acc [2]bool
@@ -2631,18 +7758,17 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[25].acc = acc[:]
-a0[25].f = fun[:]
-a0[25].id = 25
+a0[52].acc = acc[:]
+a0[52].f = fun[:]
+a0[52].id = 52
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
+  case 60: return 1
   case 61: return -1
-  case 60: return -1
   default:
     switch {
     default: return -1
@@ -2652,8 +7778,8 @@ fun[2] = func(r rune) int {
 }
 fun[1] = func(r rune) int {
   switch(r) {
-  case 61: return 2
   case 60: return -1
+  case 61: return 2
   default:
     switch {
     default: return -1
@@ -2661,9 +7787,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
-  case 60: return 1
+  case 60: return -1
   case 61: return -1
   default:
     switch {
@@ -2672,9 +7799,9 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[26].acc = acc[:]
-a0[26].f = fun[:]
-a0[26].id = 26
+a0[53].acc = acc[:]
+a0[53].f = fun[:]
+a0[53].id = 53
 }
 {
 var acc [2]bool
@@ -2700,17 +7827,17 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[27].acc = acc[:]
-a0[27].f = fun[:]
-a0[27].id = 27
+a0[54].acc = acc[:]
+a0[54].f = fun[:]
+a0[54].id = 54
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 61: return 2
-  case 62: return -1
+  case 61: return -1
+  case 62: return 1
   default:
     switch {
     default: return -1
@@ -2718,11 +7845,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
+  case 61: return 2
   case 62: return -1
-  case 61: return -1
   default:
     switch {
     default: return -1
@@ -2730,10 +7856,11 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
   case 61: return -1
-  case 62: return 1
+  case 62: return -1
   default:
     switch {
     default: return -1
@@ -2741,17 +7868,17 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[28].acc = acc[:]
-a0[28].f = fun[:]
-a0[28].id = 28
+a0[55].acc = acc[:]
+a0[55].f = fun[:]
+a0[55].id = 55
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
 fun[0] = func(r rune) int {
   switch(r) {
-  case 61: return -1
   case 33: return 1
+  case 61: return -1
   default:
     switch {
     default: return -1
@@ -2773,8 +7900,8 @@ fun[1] = func(r rune) int {
 acc[2] = true
 fun[2] = func(r rune) int {
   switch(r) {
-  case 61: return -1
   case 33: return -1
+  case 61: return -1
   default:
     switch {
     default: return -1
@@ -2782,17 +7909,17 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[29].acc = acc[:]
-a0[29].f = fun[:]
-a0[29].id = 29
+a0[56].acc = acc[:]
+a0[56].f = fun[:]
+a0[56].id = 56
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
 fun[0] = func(r rune) int {
   switch(r) {
-  case 62: return -1
   case 60: return 1
+  case 62: return -1
   default:
     switch {
     default: return -1
@@ -2800,11 +7927,10 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-acc[2] = true
-fun[2] = func(r rune) int {
+fun[1] = func(r rune) int {
   switch(r) {
-  case 62: return -1
   case 60: return -1
+  case 62: return 2
   default:
     switch {
     default: return -1
@@ -2812,10 +7938,11 @@ fun[2] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[1] = func(r rune) int {
+acc[2] = true
+fun[2] = func(r rune) int {
   switch(r) {
-  case 62: return 2
   case 60: return -1
+  case 62: return -1
   default:
     switch {
     default: return -1
@@ -2823,9 +7950,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[30].acc = acc[:]
-a0[30].f = fun[:]
-a0[30].id = 30
+a0[57].acc = acc[:]
+a0[57].f = fun[:]
+a0[57].id = 57
 }
 {
 var acc [2]bool
@@ -2851,9 +7978,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[31].acc = acc[:]
-a0[31].f = fun[:]
-a0[31].id = 31
+a0[58].acc = acc[:]
+a0[58].f = fun[:]
+a0[58].id = 58
 }
 {
 var acc [2]bool
@@ -2879,9 +8006,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[32].acc = acc[:]
-a0[32].f = fun[:]
-a0[32].id = 32
+a0[59].acc = acc[:]
+a0[59].f = fun[:]
+a0[59].id = 59
 }
 {
 var acc [2]bool
@@ -2907,9 +8034,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[33].acc = acc[:]
-a0[33].f = fun[:]
-a0[33].id = 33
+a0[60].acc = acc[:]
+a0[60].f = fun[:]
+a0[60].id = 60
 }
 {
 var acc [2]bool
@@ -2935,9 +8062,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[34].acc = acc[:]
-a0[34].f = fun[:]
-a0[34].id = 34
+a0[61].acc = acc[:]
+a0[61].f = fun[:]
+a0[61].id = 61
 }
 {
 var acc [2]bool
@@ -2963,17 +8090,16 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[35].acc = acc[:]
-a0[35].f = fun[:]
-a0[35].id = 35
+a0[62].acc = acc[:]
+a0[62].f = fun[:]
+a0[62].id = 62
 }
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 125: return -1
+  case 125: return 1
   default:
     switch {
     default: return -1
@@ -2981,9 +8107,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 125: return 1
+  case 125: return -1
   default:
     switch {
     default: return -1
@@ -2991,9 +8118,9 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[36].acc = acc[:]
-a0[36].f = fun[:]
-a0[36].id = 36
+a0[63].acc = acc[:]
+a0[63].f = fun[:]
+a0[63].id = 63
 }
 {
 var acc [2]bool
@@ -3019,9 +8146,9 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[37].acc = acc[:]
-a0[37].f = fun[:]
-a0[37].id = 37
+a0[64].acc = acc[:]
+a0[64].f = fun[:]
+a0[64].id = 64
 }
 {
 var acc [2]bool
@@ -3047,17 +8174,16 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[38].acc = acc[:]
-a0[38].f = fun[:]
-a0[38].id = 38
+a0[65].acc = acc[:]
+a0[65].f = fun[:]
+a0[65].id = 65
 }
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 58: return -1
+  case 58: return 1
   default:
     switch {
     default: return -1
@@ -3065,9 +8191,10 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 58: return 1
+  case 58: return -1
   default:
     switch {
     default: return -1
@@ -3075,18 +8202,17 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[39].acc = acc[:]
-a0[39].f = fun[:]
-a0[39].id = 39
+a0[66].acc = acc[:]
+a0[66].f = fun[:]
+a0[66].id = 66
 }
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
-  case 10: return 1
   case 9: return 1
+  case 10: return 1
   case 32: return 1
   default:
     switch {
@@ -3095,10 +8221,11 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
-  case 10: return 1
   case 9: return 1
+  case 10: return 1
   case 32: return 1
   default:
     switch {
@@ -3107,32 +8234,17 @@ fun[0] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[40].acc = acc[:]
-a0[40].f = fun[:]
-a0[40].id = 40
+a0[67].acc = acc[:]
+a0[67].f = fun[:]
+a0[67].id = 67
 }
 {
 var acc [3]bool
 var fun [3]func(rune) int
-acc[2] = true
-fun[2] = func(r rune) int {
-  switch(r) {
-  case 45: return 2
-  case 95: return 2
-  default:
-    switch {
-    case 48 <= r && r <= 57: return 2
-    case 65 <= r && r <= 90: return 2
-    case 97 <= r && r <= 122: return 2
-    default: return -1
-    }
-  }
-  panic("unreachable")
-}
 fun[0] = func(r rune) int {
   switch(r) {
-  case 95: return 1
   case 45: return -1
+  case 95: return 1
   default:
     switch {
     case 48 <= r && r <= 57: return -1
@@ -3146,8 +8258,23 @@ fun[0] = func(r rune) int {
 acc[1] = true
 fun[1] = func(r rune) int {
   switch(r) {
+  case 45: return 2
   case 95: return 2
+  default:
+    switch {
+    case 48 <= r && r <= 57: return 2
+    case 65 <= r && r <= 90: return 2
+    case 97 <= r && r <= 122: return 2
+    default: return -1
+    }
+  }
+  panic("unreachable")
+}
+acc[2] = true
+fun[2] = func(r rune) int {
+  switch(r) {
   case 45: return 2
+  case 95: return 2
   default:
     switch {
     case 48 <= r && r <= 57: return 2
@@ -3158,37 +8285,37 @@ fun[1] = func(r rune) int {
   }
   panic("unreachable")
 }
-a0[41].acc = acc[:]
-a0[41].f = fun[:]
-a0[41].id = 41
+a0[68].acc = acc[:]
+a0[68].f = fun[:]
+a0[68].id = 68
 }
 {
 var acc [2]bool
 var fun [2]func(rune) int
-acc[1] = true
-fun[1] = func(r rune) int {
+fun[0] = func(r rune) int {
   switch(r) {
   default:
     switch {
-    default: return -1
+    default: return 1
     }
   }
   panic("unreachable")
 }
-fun[0] = func(r rune) int {
+acc[1] = true
+fun[1] = func(r rune) int {
   switch(r) {
   default:
     switch {
-    default: return 1
+    default: return -1
     }
   }
   panic("unreachable")
 }
-a0[42].acc = acc[:]
-a0[42].f = fun[:]
-a0[42].id = 42
+a0[69].acc = acc[:]
+a0[69].f = fun[:]
+a0[69].id = 69
 }
-a[0].endcase = 43
+a[0].endcase = 70
 a[0].a = a0[:]
 }
 func getAction(c *frame) int {
@@ -3315,85 +8442,139 @@ func (yylex Lexer) Lex(lval *yySymType) int {
 { logDebugTokens("TRUE"); return TRUE }
     case 5:  //FALSE|false/
 { logDebugTokens("FALSE"); return FALSE }
-    case 6:  //null/
+    case 6:  //NULL|null/
 { logDebugTokens("NULL"); return NULL }
-    case 7:  //SELECT|select/
+    case 7:  //MISSING|missing/
+{ logDebugTokens("MISSING"); return MISSING }
+    case 8:  //VALUED|valued/
+{ logDebugTokens("VALUED"); return VALUED }
+    case 9:  //EXPLAIN|explain/
+{ logDebugTokens("EXPLAIN"); return EXPLAIN }
+    case 10:  //SELECT|select/
 { logDebugTokens("SELECT"); return SELECT }
-    case 8:  //WHERE|where/
+    case 11:  //AS|as/
+{ logDebugTokens("AS"); return AS }
+    case 12:  //DISTINCT|distinct/
+{ logDebugTokens("DISTINCT"); return DISTINCT }
+    case 13:  //UNIQUE|unique/
+{ logDebugTokens("UNIQUE"); return UNIQUE }
+    case 14:  //FROM|from/
+{ logDebugTokens("FROM"); return FROM }
+    case 15:  //OVER|over/
+{ logDebugTokens("OVER"); return OVER }
+    case 16:  //ANY|any/
+{ logDebugTokens("ANY"); return ANY }
+    case 17:  //ALL|all/
+{ logDebugTokens("ALL"); return ALL }
+    case 18:  //IN|in/
+{ logDebugTokens("IN"); return IN }
+    case 19:  //SATISFIES|satisfies/
+{ logDebugTokens("SATISFIES"); return SATISFIES }
+    case 20:  //END|end/
+{ logDebugTokens("END"); return END }
+    case 21:  //FIRST|first/
+{ logDebugTokens("FIRST"); return FIRST }
+    case 22:  //IF|if/
+{ logDebugTokens("IF"); return IF }
+    case 23:  //CASE|case/
+{ logDebugTokens("CASE"); return CASE }
+    case 24:  //WHEN|when/
+{ logDebugTokens("WHEN"); return WHEN }
+    case 25:  //THEN|then/
+{ logDebugTokens("THEN"); return THEN }
+    case 26:  //ELSE|else/
+{ logDebugTokens("ELSE"); return ELSE }
+    case 27:  //WHERE|where/
 { logDebugTokens("WHERE"); return WHERE }
-    case 9:  //ORDER|order/
+    case 28:  //GROUP|group/
+{ logDebugTokens("GROUP"); return GROUP }
+    case 29:  //HAVING|having/
+{ logDebugTokens("HAVING"); return HAVING }
+    case 30:  //ORDER|order/
 { logDebugTokens("ORDER"); return ORDER }
-    case 10:  //BY|by/
+    case 31:  //BY|by/
 { logDebugTokens("BY"); return BY }
-    case 11:  //ASC|asc/
+    case 32:  //ASC|asc/
 { logDebugTokens("ASC"); return ASC }
-    case 12:  //DESC|desc/
+    case 33:  //DESC|desc/
 { logDebugTokens("DESC"); return DESC }
-    case 13:  //OFFSET|offset/
+    case 34:  //OFFSET|offset/
 { logDebugTokens("OFFSET"); return OFFSET }
-    case 14:  //LIMIT|limit/
+    case 35:  //LIMIT|limit/
 { logDebugTokens("LIMIT"); return LIMIT }
-    case 15:  //\+/
+    case 36:  //\+/
 { logDebugTokens("PLUS"); return PLUS }
-    case 16:  //-/
+    case 37:  //-/
 { logDebugTokens("MINUS"); return MINUS }
-    case 17:  //\*/
+    case 38:  //\*/
 { logDebugTokens("MULT"); return MULT }
-    case 18:  //\//
+    case 39:  //\//
 { logDebugTokens("DIV"); return DIV }
-    case 19:  //\=/
+    case 40:  //%/
+{ logDebugTokens("MOD"); return MOD }
+    case 41:  //\|\|/
+{ logDebugTokens("CONCAT"); return CONCAT }
+    case 42:  //\=/
 { logDebugTokens("EQ"); return EQ }
-    case 20:  //AND|and/
+    case 43:  //AND|and/
 { logDebugTokens("AND"); return AND }
-    case 21:  //OR|or/
+    case 44:  //OR|or/
 { logDebugTokens("OR"); return OR }
-    case 22:  //\!/
+    case 45:  //LIKE|like/
+{ logDebugTokens("LIKE"); return LIKE }
+    case 46:  //BETWEEN|between/
+{ logDebugTokens("BETWEEN"); return BETWEEN }
+    case 47:  //IS|is/
+{ logDebugTokens("IS"); return IS }
+    case 48:  //(IS|is)[ \t\n]+(NOT|not)/
+{ logDebugTokens("IS_NOT"); return IS_NOT }
+    case 49:  //\!/
 { logDebugTokens("NOT"); return NOT }
-    case 23:  //\!\=/
+    case 50:  //\!\=/
 { logDebugTokens("NE"); return NE }
-    case 24:  //\<\>/
+    case 51:  //\<\>/
 { logDebugTokens("NE"); return NE }
-    case 25:  //\</
+    case 52:  //\</
 { logDebugTokens("LT"); return LT }
-    case 26:  //\<\=/
+    case 53:  //\<\=/
 { logDebugTokens("LTE"); return LTE }
-    case 27:  //\>/
+    case 54:  //\>/
 { logDebugTokens("GT"); return GT }
-    case 28:  //\>\=/
+    case 55:  //\>\=/
 { logDebugTokens("GTE"); return GTE }
-    case 29:  //\!\=/
+    case 56:  //\!\=/
 { logDebugTokens("NE"); return NE }
-    case 30:  //\<\>/
+    case 57:  //\<\>/
 { logDebugTokens("NE"); return NE }
-    case 31:  //\./
+    case 58:  //\./
 { logDebugTokens("DOT"); return DOT }
-    case 32:  //\(/
+    case 59:  //\(/
 { logDebugTokens("LPAREN"); return LPAREN }
-    case 33:  //\)/
+    case 60:  //\)/
 { logDebugTokens("RPAREN"); return RPAREN }
-    case 34:  //\,/
+    case 61:  //\,/
 { logDebugTokens("COMMA"); return COMMA }
-    case 35:  //\{/
+    case 62:  //\{/
 { logDebugTokens("LBRACE"); return LBRACE }
-    case 36:  //\}/
+    case 63:  //\}/
 { logDebugTokens("RBRACE"); return RBRACE }
-    case 37:  //\[/
+    case 64:  //\[/
 { logDebugTokens("LBRACKET"); return LBRACKET }
-    case 38:  //\]/
+    case 65:  //\]/
 { logDebugTokens("RBRACKET"); return RBRACKET }
-    case 39:  //\:/
+    case 66:  //\:/
 { logDebugTokens("COLON"); return COLON }
-    case 40:  //[ \t\n]+/
+    case 67:  //[ \t\n]+/
 { logDebugTokens("WHITESPACE (count=%d)", len(yylex.Text())) /* eat up whitespace */ }
-    case 41:  //[a-zA-Z_][a-zA-Z0-9\-_]*/
+    case 68:  //[a-zA-Z_][a-zA-Z0-9\-_]*/
 { 
                         lval.s = yylex.Text();
                         logDebugTokens("IDENTIFIER: %s", lval.s);
                         return IDENTIFIER 
                     }
-    case 42:  //./
+    case 69:  //./
 { log.Printf("see problem: %v", yylex.Text()); return int(yylex.Text()[0]) }
-    case 43:  ///
+    case 70:  ///
 // [END]
     }
   }
@@ -21,16 +21,23 @@ f float64}
 %token INT REAL STRING TRUE FALSE NULL
 %token IDENTIFIER DOT
 %token LBRACKET RBRACKET COMMA LBRACE RBRACE COLON
-%token PLUS MINUS MULT DIV
-%token SELECT WHERE ORDER BY ASC DESC
+%token PLUS MINUS MULT DIV MOD CONCAT
+%token EXPLAIN SELECT AS DISTINCT UNIQUE FROM OVER WHERE GROUP HAVING ORDER BY ASC DESC
 %token OFFSET LIMIT
+%token ANY ALL IN SATISFIES END FIRST IF
+%token CASE WHEN THEN ELSE
 %token LPAREN RPAREN
-%token AND OR NOT
+%token AND OR NOT IS IS_NOT LIKE BETWEEN MISSING VALUED
 %token LT LTE GT GTE EQ NE 
+%nonassoc IF
+%nonassoc OVER LBRACKET DOT
 %left OR
 %left AND 
 %left EQ LT LTE GT GTE NE
-%left PLUS MINUS MULT DIV MOD
+%nonassoc LIKE IN BETWEEN
+%nonassoc IS IS_NOT
+%left PLUS MINUS CONCAT
+%left MULT DIV MOD
 %right NOT
 %right QUESTION
 %%
@@ -38,6 +45,16 @@ f float64}
 input: select_stmt { 
 	logDebugGrammar("INPUT") 
 }
+|
+EXPLAIN select_stmt {
+	logDebugGrammar("INPUT - EXPLAIN")
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.Explain = true
+	default:
+		logDebugGrammar("This statement does not support EXPLAIN")
+	}
+}
 ;
 
 select_stmt:	select_compound select_order select_limit_offset {
@@ -50,12 +67,12 @@ select_compound:    select_core {
 }
 ;
 
-select_core:    select_select select_where { 
+select_core:    select_select select_from select_where select_group { 
 	logDebugGrammar("SELECT_CORE")
 }
 ;
 
-select_select:  select_select_head select_select_tail {
+select_select:  select_select_head select_select_qualifier select_select_tail {
 	logDebugGrammar("SELECT_SELECT")
 }
 ;
@@ -68,12 +85,36 @@ select_select_head:  SELECT {
 }
 ;
 
-select_select_tail:		MULT { 
-	logDebugGrammar("SELECT SELECT TAIL - STAR")
-} 
-|	expression { 
-	logDebugGrammar("SELECT SELECT TAIL - EXPR")
-	select_part := parsingStack.Pop().(ast.Expression)
+select_select_qualifier:
+/* empty */ {
+	logDebugGrammar("SELECT SELECT QUALIFIER - EMPTY")
+}
+|
+DISTINCT {
+	logDebugGrammar("SELECT SELECT QUALIFIER - DISTINCT")
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.Distinct = true
+	default:
+		logDebugGrammar("This statement does not support DISTINCT")
+	}
+}
+|
+UNIQUE {
+	logDebugGrammar("SELECT SELECT QUALIFIER - UNIQUE")
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.Distinct = true
+	default:
+		logDebugGrammar("This statement does not support UNIQUE")
+	}
+}
+;
+
+select_select_tail:	result_list {
+	logDebugGrammar("SELECT SELECT TAIL - RESULT LIST")
+	select_part := parsingStack.Pop().(ast.ResultExpressionList)
+	select_part.AssignDefaultNames()
 	switch parsingStatement := parsingStatement.(type) {
 	case *ast.SelectStatement:
 		parsingStatement.Select = select_part
@@ -84,6 +125,109 @@ select_select_tail:		MULT {
 }
 ;
 
+result_list:
+result_single {
+	logDebugGrammar("RESULT LIST - RESULT")
+	result_list := make(ast.ResultExpressionList, 0)
+	result_list = append(result_list, parsingStack.Pop().(*ast.ResultExpression))
+	parsingStack.Push(result_list)
+}
+|
+result_single COMMA result_list {
+	logDebugGrammar("RESULT LIST - RESULT COMMA RESULT LIST")
+	rest := parsingStack.Pop().(ast.ResultExpressionList)
+	last := parsingStack.Pop()
+	new_list := make(ast.ResultExpressionList, 0, len(rest) + 1)
+	new_list = append(new_list, last.(*ast.ResultExpression))
+	for _, v := range rest {
+		new_list = append(new_list, v)
+	}
+	parsingStack.Push(new_list)
+};
+
+result_single:
+MULT {
+	logDebugGrammar("RESULT - STAR")
+	parsingStack.Push(ast.NewStarResultExpression())
+}
+|
+expression {
+	logDebugGrammar("RESULT - EXPR")
+	expr_part := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewResultExpression(expr_part))
+}
+|
+expression AS IDENTIFIER {
+	logDebugGrammar("RESULT - EXPR AS %s", $3.s)
+	expr_part := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewResultExpressionWithAlias(expr_part, $3.s))
+};
+
+select_from:
+/* empty */ {
+	logDebugGrammar("SELECT FROM - EMPTY")
+}
+|
+FROM data_source_list {
+	logDebugGrammar("SELECT FROM - DATASOURCE LIST")
+	data_sources := parsingStack.Pop().([]ast.DataSource)
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.From = data_sources
+	default:
+		logDebugGrammar("This statement does not support FROM")
+	}
+};
+
+data_source_list:
+data_source {
+	logDebugGrammar("DATASOURCE LIST - DATASOURCE")
+	data_source := parsingStack.Pop().(ast.DataSource)
+	parsingStack.Push([]ast.DataSource{data_source})
+}
+|
+data_source_list OVER property AS IDENTIFIER {
+	logDebugGrammar("DATASOURCE LIST - OVER AS %s", $5.s)
+	over_path := parsingStack.Pop().(*ast.Property)
+	data_sources := parsingStack.Pop().([]ast.DataSource)
+	data_sources = append(data_sources, ast.NewOverDataSource(over_path, $5.s))
+	parsingStack.Push(data_sources)
+};
+
+data_source:
+IDENTIFIER {
+	logDebugGrammar("DATASOURCE - %s", $1.s)
+	parsingStack.Push(ast.NewNamedDataSource($1.s))
+}
+|
+IDENTIFIER AS IDENTIFIER {
+	logDebugGrammar("DATASOURCE - %s AS %s", $1.s, $3.s)
+	parsingStack.Push(ast.NewNamedDataSourceWithAlias($1.s, $3.s))
+}
+|
+IDENTIFIER DOT data_source_path {
+	logDebugGrammar("DATASOURCE - %s.%s", $1.s, $3.s)
+	parsingStack.Push(ast.NewNamedDataSourceWithPath($1.s, $3.s, ""))
+}
+|
+IDENTIFIER DOT data_source_path AS IDENTIFIER {
+	logDebugGrammar("DATASOURCE - %s.%s AS %s", $1.s, $3.s, $5.s)
+	parsingStack.Push(ast.NewNamedDataSourceWithPath($1.s, $3.s, $5.s))
+};
+
+data_source_path:
+IDENTIFIER {
+	$$.s = $1.s
+}
+|
+data_source_path DOT IDENTIFIER {
+	$$.s = $1.s + "." + $3.s
+}
+|
+data_source_path LBRACKET INT RBRACKET {
+	$$.s = fmt.Sprintf("%s[%d]", $1.s, $3.n)
+};
+
 select_where:   
 /* empty */ { 
 	logDebugGrammar("SELECT WHERE - EMPTY")
@@ -100,6 +244,38 @@ WHERE expression {
 	}
 };
 
+select_group:
+/* empty */ {
+	logDebugGrammar("SELECT GROUP - EMPTY")
+}
+|
+GROUP BY expression_list select_having {
+	logDebugGrammar("SELECT GROUP - EXPR_LIST")
+	group_by := parsingStack.Pop().([]ast.Expression)
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.GroupBy = group_by
+	default:
+		logDebugGrammar("This statement does not support GROUP BY")
+	}
+};
+
+select_having:
+/* empty */ {
+	logDebugGrammar("SELECT HAVING - EMPTY")
+}
+|
+HAVING expression {
+	logDebugGrammar("SELECT HAVING - EXPR")
+	having_part := parsingStack.Pop().(ast.BooleanExpression)
+	switch parsingStatement := parsingStatement.(type) {
+	case *ast.SelectStatement:
+		parsingStatement.Having = having_part
+	default:
+		logDebugGrammar("This statement does not support HAVING")
+	}
+};
+
 select_order:   
 /* empty */
 |
@@ -200,38 +376,6 @@ expr {
 };
 
 expr:
-expr PLUS expr {
-	logDebugGrammar("EXPR - PLUS")
-	right := parsingStack.Pop()
-	left := parsingStack.Pop()
-	thisExpression := ast.NewPlusOperator(left.(ast.Expression), right.(ast.Expression)) 
-	parsingStack.Push(thisExpression)
-}
-|
-expr MINUS expr {
-	logDebugGrammar("EXPR - MINUS")
-	right := parsingStack.Pop()
-	left := parsingStack.Pop()
-	thisExpression := ast.NewSubtractOperator(left.(ast.Expression), right.(ast.Expression)) 
-	parsingStack.Push(thisExpression)
-}
-|
-expr MULT expr {
-	logDebugGrammar("EXPR - MULT")
-	right := parsingStack.Pop()
-	left := parsingStack.Pop()
-	thisExpression := ast.NewMultiplyOperator(left.(ast.Expression), right.(ast.Expression)) 
-	parsingStack.Push(thisExpression)
-}
-|
-expr DIV expr {
-	logDebugGrammar("EXPR - DIV")
-	right := parsingStack.Pop()
-	left := parsingStack.Pop()
-	thisExpression := ast.NewDivideOperator(left.(ast.Expression), right.(ast.Expression)) 
-	parsingStack.Push(thisExpression)
-}
-|
 expr AND expr {
 	logDebugGrammar("EXPR - AND")
 	right := parsingStack.Pop()
@@ -296,6 +440,127 @@ expr NE expr {
 	parsingStack.Push(thisExpression)
 }
 |
+expr LIKE expr {
+	logDebugGrammar("EXPR - LIKE")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewLikeOperator(left.(ast.Expression), right.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IN expr {
+	logDebugGrammar("EXPR - IN")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewInOperator(left.(ast.Expression), right.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr BETWEEN arith_expr AND arith_expr {
+	logDebugGrammar("EXPR - BETWEEN")
+	high := parsingStack.Pop()
+	low := parsingStack.Pop()
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewBetweenOperator(operand.(ast.Expression), low.(ast.Expression), high.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS NULL {
+	logDebugGrammar("EXPR - IS NULL")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsNullOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS_NOT NULL {
+	logDebugGrammar("EXPR - IS NOT NULL")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsNotNullOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS MISSING {
+	logDebugGrammar("EXPR - IS MISSING")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsMissingOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS_NOT MISSING {
+	logDebugGrammar("EXPR - IS NOT MISSING")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsNotMissingOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS VALUED {
+	logDebugGrammar("EXPR - IS VALUED")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsNotNullOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+expr IS_NOT VALUED {
+	logDebugGrammar("EXPR - IS NOT VALUED")
+	operand := parsingStack.Pop()
+	thisExpression := ast.NewIsNullOperator(operand.(ast.Expression))
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr %prec IF {
+	// the bounds of BETWEEN are arithmetic so its AND is not a conjunction
+}
+;
+
+arith_expr:
+arith_expr PLUS arith_expr {
+	logDebugGrammar("EXPR - PLUS")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewPlusOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr MINUS arith_expr {
+	logDebugGrammar("EXPR - MINUS")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewSubtractOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr CONCAT arith_expr {
+	logDebugGrammar("EXPR - CONCAT")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewConcatOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr MULT arith_expr {
+	logDebugGrammar("EXPR - MULT")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewMultiplyOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr DIV arith_expr {
+	logDebugGrammar("EXPR - DIV")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewDivideOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
+arith_expr MOD arith_expr {
+	logDebugGrammar("EXPR - MOD")
+	right := parsingStack.Pop()
+	left := parsingStack.Pop()
+	thisExpression := ast.NewModuloOperator(left.(ast.Expression), right.(ast.Expression)) 
+	parsingStack.Push(thisExpression)
+}
+|
 prefix_expr {
 	
 }
@@ -306,13 +571,60 @@ NOT prefix_expr {
 	logDebugGrammar("EXPR - NOT")
 }
 |
-suffix_expr {
-	
+MINUS prefix_expr {
+	logDebugGrammar("EXPR - MINUS")
+	operand := parsingStack.Pop()
+	switch operand := operand.(type) {
+	case *ast.LiteralNumber:
+		// keep negative numbers literal so they can be index keys
+		parsingStack.Push(ast.NewLiteralNumber(-operand.Value))
+	default:
+		parsingStack.Push(ast.NewNegateOperator(operand.(ast.Expression)))
+	}
+}
+|
+suffix_expr %prec IF {
+	// a subscript following a comprehension applies to its last condition
 };
 
 suffix_expr: 
 atom {
 	logDebugGrammar("SUFFIX_EXPR")
+}
+|
+suffix_expr LBRACKET expr RBRACKET {
+	logDebugGrammar("SUFFIX_EXPR - []")
+	index := parsingStack.Pop().(ast.Expression)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(newSubscript(operand, index))
+}
+|
+suffix_expr LBRACKET expr COLON expr RBRACKET {
+	logDebugGrammar("SUFFIX_EXPR - [:]")
+	end := parsingStack.Pop().(ast.Expression)
+	start := parsingStack.Pop().(ast.Expression)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewSliceOperator(operand, start, end))
+}
+|
+suffix_expr LBRACKET expr COLON RBRACKET {
+	logDebugGrammar("SUFFIX_EXPR - [start:]")
+	start := parsingStack.Pop().(ast.Expression)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewSliceOperator(operand, start, nil))
+}
+|
+suffix_expr LBRACKET COLON expr RBRACKET {
+	logDebugGrammar("SUFFIX_EXPR - [:end]")
+	end := parsingStack.Pop().(ast.Expression)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewSliceOperator(operand, nil, end))
+}
+|
+suffix_expr DOT IDENTIFIER {
+	logDebugGrammar("SUFFIX_EXPR - .")
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(newField(operand, $3.s))
 };
 
 atom: 
@@ -325,33 +637,26 @@ NULL {
 property {
 
 }
-// FIXME enable element match and bracket member
-//|
-//property LBRACKET expression RBRACKET {
-//	logDebugGrammar("ATOM - prop[]")
-//	rightExpr := parsingStack.Pop().(ast.Expression)
-//	leftProp := parsingStack.Pop().(*ast.Property)
-//	thisExpression := parser.NewBracketMemberExpression(leftProp, rightExpr)
-//	parsingStack.Push(thisExpression)
-//}
 |
-INT { 
-	thisExpression := ast.NewLiteralNumber(float64($1.n))
-	parsingStack.Push(thisExpression)
+function_call {
+
 }
 |
-MINUS INT {
-	thisExpression := ast.NewLiteralNumber(float64(-$1.n))
-	parsingStack.Push(thisExpression)
+collection_expr {
+
 }
 |
-REAL {
-	thisExpression := ast.NewLiteralNumber($1.f)
+case_expr {
+
+}
+|
+INT { 
+	thisExpression := ast.NewLiteralNumber(float64($1.n))
 	parsingStack.Push(thisExpression)
 }
 |
-MINUS REAL {
-	thisExpression := ast.NewLiteralNumber(-$1.f)
+REAL {
+	thisExpression := ast.NewLiteralNumber($1.f)
 	parsingStack.Push(thisExpression)
 }
 |
@@ -381,6 +686,22 @@ LBRACKET expression_list RBRACKET {
 	parsingStack.Push(thisExpression)
 }
 |
+LBRACKET expr comprehension_overs RBRACKET {
+	logDebugGrammar("ATOM - [expr OVER]")
+	overs := parsingStack.Pop().([]*ast.ComprehensionOver)
+	output := parsingStack.Pop().(ast.Expression)
+	thisExpression := ast.NewArrayComprehension(output, overs)
+	parsingStack.Push(thisExpression)
+}
+|
+FIRST expr comprehension_overs {
+	logDebugGrammar("ATOM - FIRST expr OVER")
+	overs := parsingStack.Pop().([]*ast.ComprehensionOver)
+	output := parsingStack.Pop().(ast.Expression)
+	thisExpression := ast.NewFirstComprehension(output, overs)
+	parsingStack.Push(thisExpression)
+}
+|
 LPAREN expression RPAREN {
 	
 }
@@ -409,6 +730,88 @@ expression COMMA expression_list {
 	parsingStack.Push(new_list)
 };
 
+case_expr:
+CASE case_whens END {
+	logDebugGrammar("CASE_EXPR - CASE WHEN END")
+	whenThens := parsingStack.Pop().([]*ast.WhenThen)
+	parsingStack.Push(ast.NewCaseOperator(nil, whenThens, nil))
+}
+|
+CASE case_whens ELSE expr END {
+	logDebugGrammar("CASE_EXPR - CASE WHEN ELSE END")
+	elseExpression := parsingStack.Pop().(ast.Expression)
+	whenThens := parsingStack.Pop().([]*ast.WhenThen)
+	parsingStack.Push(ast.NewCaseOperator(nil, whenThens, elseExpression))
+}
+|
+CASE expr case_whens END {
+	logDebugGrammar("CASE_EXPR - CASE expr WHEN END")
+	whenThens := parsingStack.Pop().([]*ast.WhenThen)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewCaseOperator(operand, whenThens, nil))
+}
+|
+CASE expr case_whens ELSE expr END {
+	logDebugGrammar("CASE_EXPR - CASE expr WHEN ELSE END")
+	elseExpression := parsingStack.Pop().(ast.Expression)
+	whenThens := parsingStack.Pop().([]*ast.WhenThen)
+	operand := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewCaseOperator(operand, whenThens, elseExpression))
+};
+
+case_whens:
+case_when {
+	logDebugGrammar("CASE_WHENS - CASE_WHEN")
+	whenThen := parsingStack.Pop().(*ast.WhenThen)
+	parsingStack.Push([]*ast.WhenThen{whenThen})
+}
+|
+case_when case_whens {
+	logDebugGrammar("CASE_WHENS - CASE_WHEN CASE_WHENS")
+	rest := parsingStack.Pop().([]*ast.WhenThen)
+	first := parsingStack.Pop().(*ast.WhenThen)
+	parsingStack.Push(append([]*ast.WhenThen{first}, rest...))
+};
+
+case_when:
+WHEN expr THEN expr {
+	logDebugGrammar("CASE_WHEN - WHEN expr THEN expr")
+	then := parsingStack.Pop().(ast.Expression)
+	when := parsingStack.Pop().(ast.Expression)
+	parsingStack.Push(ast.NewWhenThen(when, then))
+};
+
+comprehension_overs:
+comprehension_over %prec IF {
+	// a nested comprehension takes any OVER clauses following it
+	logDebugGrammar("COMPREHENSION_OVERS - COMPREHENSION_OVER")
+	over := parsingStack.Pop().(*ast.ComprehensionOver)
+	parsingStack.Push([]*ast.ComprehensionOver{over})
+}
+|
+comprehension_over comprehension_overs {
+	logDebugGrammar("COMPREHENSION_OVERS - COMPREHENSION_OVER COMPREHENSION_OVERS")
+	rest := parsingStack.Pop().([]*ast.ComprehensionOver)
+	first := parsingStack.Pop().(*ast.ComprehensionOver)
+	parsingStack.Push(append([]*ast.ComprehensionOver{first}, rest...))
+};
+
+comprehension_over:
+OVER expr AS IDENTIFIER {
+	logDebugGrammar("COMPREHENSION_OVER - OVER expr AS %s", $4.s)
+	over := parsingStack.Pop().(ast.Expression)
+	parsingVariables = append(parsingVariables, $4.s)
+	parsingStack.Push(ast.NewComprehensionOver(over, $4.s, nil))
+}
+|
+OVER expr AS IDENTIFIER IF expr {
+	logDebugGrammar("COMPREHENSION_OVER - OVER expr AS %s IF expr", $4.s)
+	condition := parsingStack.Pop().(ast.BooleanExpression)
+	over := parsingStack.Pop().(ast.Expression)
+	parsingVariables = append(parsingVariables, $4.s)
+	parsingStack.Push(ast.NewComprehensionOver(over, $4.s, condition))
+};
+
 named_expression_list:
 named_expression_single {
 	
@@ -431,14 +834,75 @@ STRING COLON expression {
 	parsingStack.Push(thisExpression) 
 };
 
+collection_expr:
+ANY IDENTIFIER IN expr SATISFIES expr END {
+	logDebugGrammar("ANY %s IN expr SATISFIES expr END", $2.s)
+	condition := parsingStack.Pop().(ast.BooleanExpression)
+	over := parsingStack.Pop().(ast.Expression)
+	parsingVariables = append(parsingVariables, $2.s)
+	thisExpression := ast.NewCollectionAnyOperator(condition, over, $2.s)
+	parsingStack.Push(thisExpression)
+}
+|
+ALL IDENTIFIER IN expr SATISFIES expr END {
+	logDebugGrammar("ALL %s IN expr SATISFIES expr END", $2.s)
+	condition := parsingStack.Pop().(ast.BooleanExpression)
+	over := parsingStack.Pop().(ast.Expression)
+	parsingVariables = append(parsingVariables, $2.s)
+	thisExpression := ast.NewCollectionAllOperator(condition, over, $2.s)
+	parsingStack.Push(thisExpression)
+};
+
+function_call:
+IDENTIFIER LPAREN MULT RPAREN {
+	logDebugGrammar("AGGREGATE - %s(*)", $1.s)
+	thisExpression, err := ast.NewAggregateFunction($1.s, nil)
+	if err != nil {
+		yylex.Error(err.Error())
+	}
+	parsingStack.Push(thisExpression)
+}
+|
+IDENTIFIER LPAREN RPAREN {
+	logDebugGrammar("FUNCTION - %s()", $1.s)
+	thisExpression, err := ast.NewFunctionCall($1.s, []ast.Expression{})
+	if err != nil {
+		yylex.Error(err.Error())
+	}
+	parsingStack.Push(thisExpression)
+}
+|
+IDENTIFIER LPAREN expression_list RPAREN {
+	operands := parsingStack.Pop().([]ast.Expression)
+	if len(operands) == 1 && ast.IsAggregateFunctionName($1.s) {
+		logDebugGrammar("AGGREGATE - %s(expr)", $1.s)
+		thisExpression, err := ast.NewAggregateFunction($1.s, operands[0])
+		if err != nil {
+			yylex.Error(err.Error())
+		}
+		parsingStack.Push(thisExpression)
+	} else {
+		logDebugGrammar("FUNCTION - %s(expr_list)", $1.s)
+		thisExpression, err := ast.NewFunctionCall($1.s, operands)
+		if err != nil {
+			yylex.Error(err.Error())
+		}
+		parsingStack.Push(thisExpression)
+	}
+};
+
 property:
-IDENTIFIER {
+IDENTIFIER %prec IF {
+	// a dot following an identifier continues the path of the property
 	thisExpression := ast.NewProperty($1.s) 
+	parsingProperties = append(parsingProperties, thisExpression)
 	parsingStack.Push(thisExpression) 
 }
 |
 IDENTIFIER DOT property {
-	thisValue := parsingStack.Pop().(*ast.Property)
-	thisExpression := ast.NewProperty($1.s + "." + thisValue.Path)
+	// extend the path of the property already created
+	// so that every property is created (and tracked) once
+	thisExpression := parsingStack.Pop().(*ast.Property)
+	thisExpression.Path = $1.s + "." + thisExpression.Path
 	parsingStack.Push(thisExpression)
 };
\ No newline at end of file
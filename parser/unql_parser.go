@@ -19,6 +19,8 @@ import (
 
 var parsingStack *Stack
 var parsingStatement ast.Statement
+var parsingProperties []*ast.Property
+var parsingVariables []string
 var crashHard = false
 
 type UnqlParser struct {
@@ -30,11 +32,17 @@ func NewUnqlParser() *UnqlParser {
 }
 
 func (u *UnqlParser) Parse(input string) (returnStatement ast.Statement, err error) {
+	return u.ParseWithDefaultDataSource(input, "")
+}
+
+func (u *UnqlParser) ParseWithDefaultDataSource(input string, dataSource string) (returnStatement ast.Statement, err error) {
 	u.mutex.Lock()
 	defer u.mutex.Unlock()
 
 	parsingStack = new(Stack)
 	parsingStatement = nil
+	parsingProperties = make([]*ast.Property, 0)
+	parsingVariables = make([]string, 0)
 
 	defer func() {
 		r := recover()
@@ -53,5 +61,87 @@ func (u *UnqlParser) Parse(input string) (returnStatement ast.Statement, err err
 
 	yyParse(NewLexer(strings.NewReader(input)))
 	returnStatement = parsingStatement
+	if returnStatement != nil {
+		// the data source must be there before the aliases are resolved
+		if dataSource != "" && len(returnStatement.GetFrom()) == 0 {
+			returnStatement.SetFrom([]ast.DataSource{ast.NewNamedDataSource(dataSource)})
+		}
+		err = checkIdentifiers(returnStatement.GetFrom(), parsingVariables)
+		if err != nil {
+			return nil, err
+		}
+		resolveAliases(returnStatement.GetFrom(), parsingProperties)
+	}
 	return
 }
+
+// an identifier introduced with AS can only be introduced once, the
+// variables of collection expressions are not resolved like aliases
+// so they must not hide the data sources
+func checkIdentifiers(from []ast.DataSource, variables []string) error {
+	seen := make(map[string]bool)
+	for _, dataSource := range from {
+		seen[dataSource.GetAlias()] = true
+	}
+	for _, variable := range variables {
+		if seen[variable] {
+			return fmt.Errorf("Identifier %v is already defined", variable)
+		}
+		seen[variable] = true
+	}
+	return nil
+}
+
+// the FROM clause follows the expressions using its alias
+// so properties can only be resolved once parsing is complete
+func resolveAliases(from []ast.DataSource, properties []*ast.Property) {
+	if len(from) == 0 {
+		return
+	}
+
+	// an OVER path not beginning with the alias to its immediate
+	// left is relative to it, this must happen before resolving
+	leftAlias := from[0].GetAlias()
+	for _, dataSource := range from[1:] {
+		switch dataSource := dataSource.(type) {
+		case *ast.OverDataSource:
+			property := dataSource.GetProperty()
+			if !ast.HasAliasPrefix(property.Path, leftAlias) {
+				property.Path = leftAlias + "." + property.Path
+			}
+		}
+		leftAlias = dataSource.GetAlias()
+	}
+
+	// only the bucket is found under a different key in the row
+	// the elements joined by OVER are found under their alias
+	for _, property := range properties {
+		property.Path = ast.ResolveAlias(property.Path, from[0].GetAlias())
+	}
+}
+
+// a literal index into a property is kept as part of its path, like
+// the paths of the FROM clause, so that it can still match an index
+func newSubscript(operand ast.Expression, index ast.Expression) ast.Expression {
+	switch operand := operand.(type) {
+	case *ast.Property:
+		switch index := index.(type) {
+		case *ast.LiteralNumber:
+			if index.Value >= 0 && index.Value == float64(int(index.Value)) {
+				operand.Path = fmt.Sprintf("%v[%d]", operand.Path, int(index.Value))
+				return operand
+			}
+		}
+	}
+	return ast.NewSubscriptOperator(operand, index)
+}
+
+// a field of a property is kept as part of its path as well
+func newField(operand ast.Expression, field string) ast.Expression {
+	switch operand := operand.(type) {
+	case *ast.Property:
+		operand.Path = operand.Path + "." + field
+		return operand
+	}
+	return ast.NewFieldOperator(operand, field)
+}
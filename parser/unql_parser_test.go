@@ -10,11 +10,59 @@
 package parser
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
 )
 
 var validQueries = []string{
 	"SELECT * WHERE x = 1",
+	"SELECT COUNT(*) WHERE x = 1",
+	"SELECT {\"type\": doc.type, \"count\": count(*), \"avg\": AVG(doc.abv)} GROUP BY doc.type",
+	"SELECT SUM(doc.abv) GROUP BY doc.type, doc.brewery ORDER BY MAX(doc.abv) DESC LIMIT 10",
+	"SELECT doc.type GROUP BY doc.type HAVING COUNT(*) > 10",
+	"SELECT doc.type WHERE doc.abv > 5 GROUP BY doc.type HAVING MIN(doc.abv) > 6 AND doc.type != \"stout\" ORDER BY doc.type",
+	"SELECT DISTINCT doc.type",
+	"SELECT UNIQUE {\"type\": doc.type, \"brewery\": doc.brewery} WHERE doc.abv > 5 ORDER BY doc.type",
+	"SELECT DISTINCT *",
+	"SELECT doc.name, doc.abv * 2 AS double_abv, doc.brewery.city",
+	"SELECT *, doc.name AS name",
+	"SELECT DISTINCT doc.type AS type, COUNT(*) AS count GROUP BY doc.type",
+	"EXPLAIN SELECT * WHERE doc.abv > 5",
+	"explain SELECT DISTINCT doc.type ORDER BY doc.type",
+	"SELECT * FROM beer-sample",
+	"SELECT b.name, b.abv * 2 AS double_abv FROM beer-sample AS b WHERE b.abv > 5 ORDER BY b.name",
+	"SELECT address.city FROM organizations.address",
+	"SELECT line.price FROM orders.lines[0] AS line WHERE line.qty > 1",
+	"SELECT * FROM orders.shipping.address AS addr",
+	"SELECT employee.name FROM organizations AS organization OVER organization.employees AS employee",
+	"SELECT address.city FROM organization AS org OVER org.employees AS employee OVER employee.addresses AS address WHERE org.name = \"o1\"",
+	"SELECT child.name FROM contacts AS contact OVER children AS child",
+	"SELECT UPPER(doc.name) AS name, ROUND(doc.abv, 1), META() AS meta WHERE LENGTH(doc.name) > 5",
+	"SELECT VALUE() WHERE ifnull(doc.abv, 0) > 5",
+	"SELECT GREATEST(doc.abv, doc.ibu, 10) ORDER BY substr(doc.name, -4, 2)",
+	"SELECT doc.type, ROUND(AVG(doc.abv), 2) GROUP BY doc.type HAVING CEIL(MAX(doc.abv)) > 6",
+	"SELECT * WHERE doc.abv IS NULL",
+	"SELECT * WHERE doc.abv IS NOT NULL AND doc.ibu is not missing",
+	"SELECT * WHERE doc.abv IS MISSING OR doc.abv IS VALUED OR doc.abv IS  NOT  VALUED",
+	"SELECT * WHERE doc.name LIKE \"Bud*\"",
+	"SELECT * WHERE doc.name like \"%ale_\" AND doc.style LIKE doc.pattern",
+	"SELECT * FROM contacts AS contact WHERE ANY child IN contact.children SATISFIES child.age > 6 END",
+	"SELECT * WHERE all child in doc.children satisfies child.age > 6 AND ANY toy IN child.toys SATISFIES toy = \"ball\" END end",
+	"SELECT FIRST c OVER doc.children AS c IF c.age > 6 AS eldest",
+	"SELECT [c.name OVER doc.children AS c IF c.age > 6 OVER c.toys AS t] WHERE LENGTH([u OVER doc.toys AS u IF u = \"ball\"]) > 0",
+	"SELECT * WHERE (first c over doc.children as c if c.age > 6) = doc.eldest",
+	"SELECT doc.abv % 2, -doc.abv, - (doc.abv + 1) WHERE doc.ibu % 10 = 0 AND doc.abv > -5.5",
+	"SELECT doc.firstname || \" \" || doc.lastname AS name WHERE CONTAINS(doc.name, \"ale\") OR STARTS_WITH(doc.name, \"Bud\") OR ENDS_WITH(doc.name, \"IPA\")",
+	"SELECT [1, 2, 3][0], LOWER(doc.name)[0], doc.tags[1:3], doc.tags[-1], doc.tags[:2], doc.tags[1:] WHERE doc.tags[doc.count - 1] = \"x\"",
+	"SELECT {\"a\": {\"b\": 1}}.a.b, doc[doc.field], doc.lines[0].qty, META().id, OBJECT_KEYS(doc), OBJECT_VALUES(doc.address), OBJECT_PAIRS({\"a\": 1})",
+	"SELECT CASE WHEN doc.abv > 7 THEN \"strong\" WHEN doc.abv > 4 THEN \"regular\" ELSE \"light\" END AS strength WHERE CASE doc.type WHEN \"beer\" THEN doc.abv > 5 ELSE true END ORDER BY CASE WHEN doc.abv IS NULL THEN 0 ELSE doc.abv END",
+	"select case doc.type when \"beer\" then 1 end, CASE WHEN CASE WHEN doc.a THEN doc.b END THEN 1 END.x",
+	"SELECT POSITION(doc.name, \" \"), REPLACE(doc.name, \" \", \"-\"), SPLIT(doc.name), split(doc.name, \",\") WHERE REGEXP_MATCH(doc.name, \"[A-Z].*\")",
+	"SELECT * WHERE doc.color IN [\"red\", \"blue\"] OR doc.abv in [5, 6] AND doc.type IN doc.types",
+	"SELECT * WHERE ANY child IN doc.children SATISFIES child.name IN [\"a\", \"b\"] END",
+	"SELECT * WHERE doc.price BETWEEN 10 AND 20 AND doc.date between \"2013-01-01\" and \"2013-12-31\" OR doc.abv BETWEEN doc.ibu / 10 AND -doc.og + 1",
 }
 
 var invalidQueries = []string{
@@ -22,6 +70,72 @@ var invalidQueries = []string{
 	"SELECT WHERE x = 1",
 	"* WHERE x = 1",
 	"SELECT * WHERE",
+	"SELECT * GROUP BY",
+	"SELECT MEDIAN(doc.abv)",
+	"SELECT SUM(*)",
+	"SELECT * HAVING COUNT(*) > 10",
+	"SELECT * GROUP BY doc.type HAVING",
+	"SELECT DISTINCT",
+	"SELECT DISTINCT UNIQUE doc.type",
+	"SELECT doc.name AS",
+	"SELECT doc.name,",
+	"SELECT * AS all",
+	"EXPLAIN",
+	"SELECT * EXPLAIN",
+	"SELECT * FROM",
+	"SELECT * FROM beer-sample AS",
+	"SELECT * WHERE doc.abv > 5 FROM beer-sample",
+	"SELECT * FROM orders.lines[-1]",
+	"SELECT * FROM orders.",
+	"SELECT * FROM organizations OVER employees",
+	"SELECT * OVER doc.employees AS employee",
+	"SELECT NOSUCHFUNCTION(doc.abv)",
+	"SELECT UPPER()",
+	"SELECT META(doc)",
+	"SELECT SUM(doc.abv, doc.ibu)",
+	"SELECT LOWER(doc.name,)",
+	"SELECT * WHERE doc.abv IS",
+	"SELECT * WHERE doc.abv IS 5",
+	"SELECT * WHERE doc.abv IS NOT",
+	"SELECT * WHERE doc.name LIKE",
+	"SELECT * WHERE LIKE \"Bud*\"",
+	"SELECT * WHERE ANY child IN doc.children SATISFIES child.age > 6",
+	"SELECT * WHERE ANY IN doc.children SATISFIES doc.age > 6 END",
+	"SELECT * WHERE ANY child.name IN doc.children SATISFIES child.age > 6 END",
+	"SELECT * FROM contacts AS contact WHERE ANY contact IN contact.children SATISFIES contact.age > 6 END",
+	"SELECT * WHERE ANY child IN doc.children SATISFIES ANY child IN child.children SATISFIES child.age > 6 END END",
+	"SELECT FIRST c OVER doc.children",
+	"SELECT [c OVER doc.children AS c IF]",
+	"SELECT [c OVER doc.children AS c",
+	"SELECT [c OVER doc.children AS c], [c OVER doc.parents AS c]",
+	"SELECT FIRST c IF c.age > 6",
+	"SELECT * FROM children AS c WHERE FIRST c OVER c AS c",
+	"SELECT doc.abv %",
+	"SELECT doc.firstname ||",
+	"SELECT doc.firstname | doc.lastname",
+	"SELECT REPLACE(doc.name, \" \")",
+	"SELECT SPLIT()",
+	"SELECT doc.tags[",
+	"SELECT doc.tags[]",
+	"SELECT doc.tags[:]",
+	"SELECT doc.tags[1:2:3]",
+	"SELECT doc.",
+	"SELECT LOWER(doc.name).",
+	"SELECT doc.tags[0].1",
+	"SELECT OBJECT_KEYS(doc, doc)",
+	"SELECT CASE END",
+	"SELECT CASE ELSE 1 END",
+	"SELECT CASE WHEN doc.a THEN 1",
+	"SELECT CASE WHEN doc.a 1 END",
+	"SELECT CASE doc.a WHEN 1 THEN 2 ELSE 3 ELSE 4 END",
+	"SELECT % doc.abv",
+	"SELECT * WHERE doc.color IN",
+	"SELECT * WHERE IN [1, 2]",
+	"SELECT * WHERE doc.a IN [1] IN [true]",
+	"SELECT * WHERE doc.abv BETWEEN 5",
+	"SELECT * WHERE doc.abv BETWEEN 5 AND",
+	"SELECT * WHERE doc.abv BETWEEN 5 OR 7",
+	"SELECT * WHERE doc.abv BETWEEN doc.a > 1 AND 7",
 }
 
 func TestParser(t *testing.T) {
@@ -44,3 +158,490 @@ func TestParser(t *testing.T) {
 	}
 
 }
+
+func TestParseFromAlias(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		name   string
+		alias  string
+		where  []ast.Property
+		order  []ast.Property
+		result []ast.Property
+	}{
+		{
+			"SELECT b.name FROM beer-sample AS b WHERE b.abv > 5 ORDER BY b.brewery.name",
+			"beer-sample",
+			"b",
+			[]ast.Property{*ast.NewProperty("doc.abv")},
+			[]ast.Property{*ast.NewProperty("doc.brewery.name")},
+			[]ast.Property{*ast.NewProperty("doc.name")},
+		},
+		{
+			"SELECT beer-sample.name FROM beer-sample WHERE beer-sample.abv > 5 ORDER BY meta.id",
+			"beer-sample",
+			"beer-sample",
+			[]ast.Property{*ast.NewProperty("doc.abv")},
+			[]ast.Property{*ast.NewProperty("meta.id")},
+			[]ast.Property{*ast.NewProperty("doc.name")},
+		},
+		{
+			"SELECT address.city FROM organizations.address WHERE address.zip > 9 ORDER BY meta.id",
+			"organizations",
+			"address",
+			[]ast.Property{*ast.NewProperty("doc.zip")},
+			[]ast.Property{*ast.NewProperty("meta.id")},
+			[]ast.Property{*ast.NewProperty("doc.city")},
+		},
+		{
+			"SELECT address.city FROM organizations AS org OVER employees AS employee OVER employee.addresses AS address WHERE org.name = employee.name ORDER BY address.zip",
+			"organizations",
+			"org",
+			[]ast.Property{*ast.NewProperty("doc.name"), *ast.NewProperty("employee.name")},
+			[]ast.Property{*ast.NewProperty("address.zip")},
+			[]ast.Property{*ast.NewProperty("address.city")},
+		},
+		{
+			"SELECT CASE b.type WHEN \"beer\" THEN b.name ELSE b.brewery END FROM beer-sample AS b WHERE CASE WHEN b.abv > 5 THEN b.ibu > 50 END ORDER BY CASE WHEN b.style IS MISSING THEN 1 ELSE 0 END",
+			"beer-sample",
+			"b",
+			[]ast.Property{*ast.NewProperty("doc.abv"), *ast.NewProperty("doc.ibu")},
+			[]ast.Property{*ast.NewProperty("doc.style")},
+			[]ast.Property{*ast.NewProperty("doc.type"), *ast.NewProperty("doc.name"), *ast.NewProperty("doc.brewery")},
+		},
+		{
+			"SELECT beer.name FROM beer-sample AS b WHERE beer.abv > 5 ORDER BY b",
+			"beer-sample",
+			"b",
+			[]ast.Property{*ast.NewProperty("beer.abv")},
+			[]ast.Property{*ast.NewProperty("doc")},
+			[]ast.Property{*ast.NewProperty("beer.name")},
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		from := statement.GetFrom()
+		if len(from) < 1 || from[0].GetName() != x.name || from[0].GetAlias() != x.alias {
+			t.Errorf("Expected data source %v AS %v, got %v", x.name, x.alias, from)
+		}
+		where := statement.GetWhere().ReferencedProperties()
+		if !reflect.DeepEqual(where, x.where) {
+			t.Errorf("Expected where properties %v, got %v", x.where, where)
+		}
+		order := statement.GetOrder()[0].Expression().ReferencedProperties()
+		if !reflect.DeepEqual(order, x.order) {
+			t.Errorf("Expected order properties %v, got %v", x.order, order)
+		}
+		result := statement.GetSelect().ReferencedProperties()
+		if !reflect.DeepEqual(result, x.result) {
+			t.Errorf("Expected result properties %v, got %v", x.result, result)
+		}
+	}
+
+}
+
+func TestParseOver(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	statement, err := unqlParser.Parse("SELECT * FROM organizations AS org OVER employees AS employee OVER employee.addresses AS address")
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+
+	expected := []struct {
+		path  string
+		alias string
+	}{
+		{"", "org"},
+		{"doc.employees", "employee"},
+		{"employee.addresses", "address"},
+	}
+
+	from := statement.GetFrom()
+	if len(from) != len(expected) {
+		t.Fatalf("Expected %d data sources, got %v", len(expected), from)
+	}
+	for i, x := range expected {
+		if from[i].GetPath() != x.path || from[i].GetAlias() != x.alias {
+			t.Errorf("Expected %v AS %v, got %v", x.path, x.alias, from[i])
+		}
+	}
+
+}
+
+func TestParseIs(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{"SELECT * WHERE abv IS NULL", ast.NewIsNullOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv IS NOT NULL", ast.NewIsNotNullOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv IS MISSING", ast.NewIsMissingOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv IS NOT MISSING", ast.NewIsNotMissingOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv IS VALUED", ast.NewIsNotNullOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv IS NOT VALUED", ast.NewIsNullOperator(ast.NewProperty("abv"))},
+		{"SELECT * WHERE abv + 1 IS NULL", ast.NewIsNullOperator(ast.NewPlusOperator(ast.NewProperty("abv"), ast.NewLiteralNumber(1.0)))},
+		{
+			"SELECT * WHERE abv IS NULL = true",
+			ast.NewEqualToOperator(ast.NewIsNullOperator(ast.NewProperty("abv")), ast.NewLiteralBool(true)),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseLike(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{"SELECT * WHERE name LIKE \"Bud*\"", ast.NewLikeOperator(ast.NewProperty("name"), ast.NewLiteralString("Bud*"))},
+		{
+			"SELECT * WHERE name LIKE \"Bud*\" AND abv > 5",
+			ast.NewAndOperator([]ast.BooleanExpression{
+				ast.NewLikeOperator(ast.NewProperty("name"), ast.NewLiteralString("Bud*")),
+				ast.NewGreaterThanOperator(ast.NewProperty("abv"), ast.NewLiteralNumber(5.0)),
+			}),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseIn(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{
+			"SELECT * WHERE doc.color IN [\"red\", \"blue\"]",
+			ast.NewInOperator(ast.NewProperty("doc.color"), ast.NewLiteralArray([]ast.Expression{ast.NewLiteralString("red"), ast.NewLiteralString("blue")})),
+		},
+		{
+			"SELECT * WHERE doc.abv + 1 IN doc.values AND abv > 5",
+			ast.NewAndOperator([]ast.BooleanExpression{
+				ast.NewInOperator(ast.NewPlusOperator(ast.NewProperty("doc.abv"), ast.NewLiteralNumber(1.0)), ast.NewProperty("doc.values")),
+				ast.NewGreaterThanOperator(ast.NewProperty("abv"), ast.NewLiteralNumber(5.0)),
+			}),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseBetween(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{
+			"SELECT * WHERE doc.abv BETWEEN 5 AND 7",
+			ast.NewBetweenOperator(ast.NewProperty("doc.abv"), ast.NewLiteralNumber(5.0), ast.NewLiteralNumber(7.0)),
+		},
+		{
+			"SELECT * WHERE doc.abv BETWEEN 5 AND 7 AND doc.ibu BETWEEN doc.min + 1 AND doc.max * 2",
+			ast.NewAndOperator([]ast.BooleanExpression{
+				ast.NewBetweenOperator(ast.NewProperty("doc.abv"), ast.NewLiteralNumber(5.0), ast.NewLiteralNumber(7.0)),
+				ast.NewBetweenOperator(ast.NewProperty("doc.ibu"),
+					ast.NewPlusOperator(ast.NewProperty("doc.min"), ast.NewLiteralNumber(1.0)),
+					ast.NewMultiplyOperator(ast.NewProperty("doc.max"), ast.NewLiteralNumber(2.0))),
+			}),
+		},
+		{
+			"SELECT * WHERE doc.abv + 1 BETWEEN 5 AND 7 OR doc.ibu > 10",
+			ast.NewOrOperator([]ast.BooleanExpression{
+				ast.NewBetweenOperator(ast.NewPlusOperator(ast.NewProperty("doc.abv"), ast.NewLiteralNumber(1.0)), ast.NewLiteralNumber(5.0), ast.NewLiteralNumber(7.0)),
+				ast.NewGreaterThanOperator(ast.NewProperty("doc.ibu"), ast.NewLiteralNumber(10.0)),
+			}),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseCollection(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{
+			"SELECT * FROM contacts AS contact WHERE ANY child IN contact.children SATISFIES child.age > 6 END",
+			ast.NewCollectionAnyOperator(
+				ast.NewGreaterThanOperator(ast.NewProperty("child.age"), ast.NewLiteralNumber(6.0)),
+				ast.NewProperty("doc.children"),
+				"child"),
+		},
+		{
+			"SELECT * FROM contacts AS contact WHERE ALL child IN contact.children SATISFIES child.name = contact.name END",
+			ast.NewCollectionAllOperator(
+				ast.NewEqualToOperator(ast.NewProperty("child.name"), ast.NewProperty("doc.name")),
+				ast.NewProperty("doc.children"),
+				"child"),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseComprehension(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	children := ast.NewProperty("doc.children")
+	olderThanSix := ast.NewGreaterThanOperator(ast.NewProperty("c.age"), ast.NewLiteralNumber(6.0))
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{
+			"SELECT * FROM contacts AS contact WHERE (FIRST c OVER contact.children AS c IF c.age > 6) = contact.eldest",
+			ast.NewEqualToOperator(
+				ast.NewFirstComprehension(ast.NewProperty("c"), []*ast.ComprehensionOver{ast.NewComprehensionOver(children, "c", olderThanSix)}),
+				ast.NewProperty("doc.eldest")),
+		},
+		{
+			// the IF condition extends as far as possible
+			"SELECT * FROM contacts AS contact WHERE (FIRST c OVER contact.children AS c IF c.age > 6 AND c.age < 10) IS NOT MISSING",
+			ast.NewIsNotMissingOperator(
+				ast.NewFirstComprehension(ast.NewProperty("c"), []*ast.ComprehensionOver{
+					ast.NewComprehensionOver(children, "c", ast.NewAndOperator([]ast.BooleanExpression{
+						olderThanSix,
+						ast.NewLessThanOperator(ast.NewProperty("c.age"), ast.NewLiteralNumber(10.0)),
+					})),
+				})),
+		},
+		{
+			"SELECT * FROM contacts AS contact WHERE [t OVER contact.children AS c IF c.age > 6 OVER c.toys AS t] = contact.toys",
+			ast.NewEqualToOperator(
+				ast.NewArrayComprehension(ast.NewProperty("t"), []*ast.ComprehensionOver{
+					ast.NewComprehensionOver(children, "c", olderThanSix),
+					ast.NewComprehensionOver(ast.NewProperty("c.toys"), "t", nil),
+				}),
+				ast.NewProperty("doc.toys")),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseArithmetic(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	abv := ast.NewProperty("abv")
+	ibu := ast.NewProperty("ibu")
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{"SELECT * WHERE abv > -5", ast.NewGreaterThanOperator(abv, ast.NewLiteralNumber(-5.0))},
+		{"SELECT * WHERE abv > -5.5", ast.NewGreaterThanOperator(abv, ast.NewLiteralNumber(-5.5))},
+		{"SELECT * WHERE -abv < 5", ast.NewLessThanOperator(ast.NewNegateOperator(abv), ast.NewLiteralNumber(5.0))},
+		{"SELECT * WHERE abv - -ibu = 0", ast.NewEqualToOperator(ast.NewSubtractOperator(abv, ast.NewNegateOperator(ibu)), ast.NewLiteralNumber(0.0))},
+		{"SELECT * WHERE abv % 2 = 1", ast.NewEqualToOperator(ast.NewModuloOperator(abv, ast.NewLiteralNumber(2.0)), ast.NewLiteralNumber(1.0))},
+		{
+			"SELECT * WHERE abv + ibu % 2 = 1",
+			ast.NewEqualToOperator(ast.NewPlusOperator(abv, ast.NewModuloOperator(ibu, ast.NewLiteralNumber(2.0))), ast.NewLiteralNumber(1.0)),
+		},
+		{
+			"SELECT * WHERE abv - 2 * ibu = 1",
+			ast.NewEqualToOperator(ast.NewSubtractOperator(abv, ast.NewMultiplyOperator(ast.NewLiteralNumber(2.0), ibu)), ast.NewLiteralNumber(1.0)),
+		},
+		{
+			"SELECT * WHERE name || \"s\" = abv || ibu",
+			ast.NewEqualToOperator(
+				ast.NewConcatOperator(ast.NewProperty("name"), ast.NewLiteralString("s")),
+				ast.NewConcatOperator(abv, ibu)),
+		},
+		{
+			"SELECT * WHERE -abv * ibu = 1",
+			ast.NewEqualToOperator(ast.NewMultiplyOperator(ast.NewNegateOperator(abv), ibu), ast.NewLiteralNumber(1.0)),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseSubscript(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tags := ast.NewProperty("doc.tags")
+	one := ast.NewLiteralNumber(1.0)
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{"SELECT * FROM beer AS b WHERE b.tags[1] = 1", ast.NewEqualToOperator(ast.NewProperty("doc.tags[1]"), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[1][0] = 1", ast.NewEqualToOperator(ast.NewProperty("doc.tags[1][0]"), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[-1] = 1", ast.NewEqualToOperator(ast.NewSubscriptOperator(tags, ast.NewLiteralNumber(-1.0)), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[b.count] = 1", ast.NewEqualToOperator(ast.NewSubscriptOperator(tags, ast.NewProperty("doc.count")), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[1:] = 1", ast.NewEqualToOperator(ast.NewSliceOperator(tags, one, nil), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[:-1] = 1", ast.NewEqualToOperator(ast.NewSliceOperator(tags, nil, ast.NewLiteralNumber(-1.0)), one)},
+		{
+			"SELECT * FROM beer AS b WHERE -b.tags[1:2][0] = 1",
+			ast.NewEqualToOperator(ast.NewNegateOperator(ast.NewSubscriptOperator(ast.NewSliceOperator(tags, one, ast.NewLiteralNumber(2.0)), ast.NewLiteralNumber(0.0))), one),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseField(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	object := ast.NewLiteralObject(map[string]ast.Expression{
+		"a": ast.NewLiteralObject(map[string]ast.Expression{"b": ast.NewLiteralNumber(1.0)}),
+	})
+	meta, _ := ast.NewFunctionCall("META", []ast.Expression{})
+	one := ast.NewLiteralNumber(1.0)
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{"SELECT * FROM beer AS b WHERE b.lines[0].qty = 1", ast.NewEqualToOperator(ast.NewProperty("doc.lines[0].qty"), one)},
+		{"SELECT * FROM beer AS b WHERE {\"a\": {\"b\": 1}}.a.b = 1", ast.NewEqualToOperator(ast.NewFieldOperator(ast.NewFieldOperator(object, "a"), "b"), one)},
+		{"SELECT * FROM beer AS b WHERE META().id = 1", ast.NewEqualToOperator(ast.NewFieldOperator(meta, "id"), one)},
+		{"SELECT * FROM beer AS b WHERE b[b.field] = 1", ast.NewEqualToOperator(ast.NewSubscriptOperator(ast.NewProperty("doc"), ast.NewProperty("doc.field")), one)},
+		{"SELECT * FROM beer AS b WHERE b.tags[-1].name = 1", ast.NewEqualToOperator(ast.NewFieldOperator(ast.NewSubscriptOperator(ast.NewProperty("doc.tags"), ast.NewLiteralNumber(-1.0)), "name"), one)},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
+
+func TestParseCase(t *testing.T) {
+	unqlParser := NewUnqlParser()
+
+	tests := []struct {
+		input  string
+		output ast.Expression
+	}{
+		{
+			"SELECT * WHERE CASE WHEN doc.abv > 5 THEN true END",
+			ast.NewCaseOperator(nil, []*ast.WhenThen{
+				ast.NewWhenThen(ast.NewGreaterThanOperator(ast.NewProperty("doc.abv"), ast.NewLiteralNumber(5.0)), ast.NewLiteralBool(true)),
+			}, nil),
+		},
+		{
+			"SELECT * WHERE CASE doc.type WHEN \"beer\" THEN true WHEN \"wine\" THEN false ELSE doc.other END",
+			ast.NewCaseOperator(ast.NewProperty("doc.type"), []*ast.WhenThen{
+				ast.NewWhenThen(ast.NewLiteralString("beer"), ast.NewLiteralBool(true)),
+				ast.NewWhenThen(ast.NewLiteralString("wine"), ast.NewLiteralBool(false)),
+			}, ast.NewProperty("doc.other")),
+		},
+		{
+			"SELECT * WHERE CASE WHEN doc.a THEN 1 ELSE 2 END + 1 = 2",
+			ast.NewEqualToOperator(ast.NewPlusOperator(ast.NewCaseOperator(nil, []*ast.WhenThen{
+				ast.NewWhenThen(ast.NewProperty("doc.a"), ast.NewLiteralNumber(1.0)),
+			}, ast.NewLiteralNumber(2.0)), ast.NewLiteralNumber(1.0)), ast.NewLiteralNumber(2.0)),
+		},
+	}
+
+	for _, x := range tests {
+		statement, err := unqlParser.Parse(x.input)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", x.input, err)
+		}
+		if !reflect.DeepEqual(statement.GetWhere(), x.output) {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input, statement.GetWhere())
+		}
+	}
+
+}
@@ -38,27 +38,53 @@ const PLUS = 57360
 const MINUS = 57361
 const MULT = 57362
 const DIV = 57363
-const SELECT = 57364
-const WHERE = 57365
-const ORDER = 57366
-const BY = 57367
-const ASC = 57368
-const DESC = 57369
-const OFFSET = 57370
-const LIMIT = 57371
-const LPAREN = 57372
-const RPAREN = 57373
-const AND = 57374
-const OR = 57375
-const NOT = 57376
-const LT = 57377
-const LTE = 57378
-const GT = 57379
-const GTE = 57380
-const EQ = 57381
-const NE = 57382
-const MOD = 57383
-const QUESTION = 57384
+const MOD = 57364
+const CONCAT = 57365
+const EXPLAIN = 57366
+const SELECT = 57367
+const AS = 57368
+const DISTINCT = 57369
+const UNIQUE = 57370
+const FROM = 57371
+const OVER = 57372
+const WHERE = 57373
+const GROUP = 57374
+const HAVING = 57375
+const ORDER = 57376
+const BY = 57377
+const ASC = 57378
+const DESC = 57379
+const OFFSET = 57380
+const LIMIT = 57381
+const ANY = 57382
+const ALL = 57383
+const IN = 57384
+const SATISFIES = 57385
+const END = 57386
+const FIRST = 57387
+const IF = 57388
+const CASE = 57389
+const WHEN = 57390
+const THEN = 57391
+const ELSE = 57392
+const LPAREN = 57393
+const RPAREN = 57394
+const AND = 57395
+const OR = 57396
+const NOT = 57397
+const IS = 57398
+const IS_NOT = 57399
+const LIKE = 57400
+const BETWEEN = 57401
+const MISSING = 57402
+const VALUED = 57403
+const LT = 57404
+const LTE = 57405
+const GT = 57406
+const GTE = 57407
+const EQ = 57408
+const NE = 57409
+const QUESTION = 57410
 
 var yyToknames = []string{
 	"INT",
@@ -79,26 +105,52 @@ var yyToknames = []string{
 	"MINUS",
 	"MULT",
 	"DIV",
+	"MOD",
+	"CONCAT",
+	"EXPLAIN",
 	"SELECT",
+	"AS",
+	"DISTINCT",
+	"UNIQUE",
+	"FROM",
+	"OVER",
 	"WHERE",
+	"GROUP",
+	"HAVING",
 	"ORDER",
 	"BY",
 	"ASC",
 	"DESC",
 	"OFFSET",
 	"LIMIT",
+	"ANY",
+	"ALL",
+	"IN",
+	"SATISFIES",
+	"END",
+	"FIRST",
+	"IF",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
 	"LPAREN",
 	"RPAREN",
 	"AND",
 	"OR",
 	"NOT",
+	"IS",
+	"IS_NOT",
+	"LIKE",
+	"BETWEEN",
+	"MISSING",
+	"VALUED",
 	"LT",
 	"LTE",
 	"GT",
 	"GTE",
 	"EQ",
 	"NE",
-	"MOD",
 	"QUESTION",
 }
 var yyStatenames = []string{}
@@ -112,101 +164,217 @@ var yyExca = []int{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 130,
+	42, 0,
+	58, 0,
+	59, 0,
+	-2, 55,
+	-1, 131,
+	42, 0,
+	58, 0,
+	59, 0,
+	-2, 56,
 }
 
-const yyNprod = 60
+const yyNprod = 120
 const yyPrivate = 57344
 
 var yyTokenNames []string
 var yyStates []string
 
-const yyLast = 192
+const yyLast = 730
 
 var yyAct = []int{
 
-	56, 63, 55, 52, 21, 15, 84, 14, 37, 38,
-	39, 40, 36, 83, 34, 88, 89, 35, 9, 61,
-	11, 7, 41, 42, 16, 44, 45, 46, 47, 43,
-	48, 57, 2, 80, 78, 62, 65, 37, 38, 39,
-	40, 87, 49, 66, 67, 68, 69, 70, 71, 72,
-	73, 74, 75, 76, 77, 37, 38, 39, 40, 82,
-	79, 81, 86, 58, 85, 59, 31, 54, 53, 41,
-	50, 51, 44, 45, 46, 47, 43, 48, 19, 18,
-	60, 91, 33, 90, 64, 92, 12, 6, 65, 93,
-	37, 38, 39, 40, 10, 5, 4, 32, 8, 3,
-	1, 0, 0, 0, 0, 0, 0, 44, 45, 46,
-	47, 43, 48, 22, 24, 25, 26, 27, 20, 31,
-	0, 29, 0, 0, 28, 0, 0, 0, 23, 0,
-	0, 7, 0, 0, 0, 0, 0, 0, 0, 30,
-	0, 0, 0, 17, 22, 24, 25, 26, 27, 20,
-	31, 0, 29, 0, 0, 28, 0, 0, 0, 23,
-	13, 22, 24, 25, 26, 27, 20, 31, 0, 29,
-	30, 0, 28, 0, 17, 0, 23, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 30, 0, 0,
-	0, 17,
+	31, 98, 96, 152, 93, 187, 59, 106, 186, 32,
+	136, 158, 133, 157, 27, 39, 30, 79, 103, 219,
+	109, 58, 61, 217, 64, 81, 82, 164, 70, 71,
+	79, 81, 82, 78, 80, 163, 19, 73, 74, 75,
+	76, 72, 77, 191, 81, 82, 78, 80, 2, 192,
+	97, 99, 9, 100, 165, 57, 107, 114, 102, 110,
+	166, 137, 138, 134, 135, 112, 113, 20, 11, 195,
+	30, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 115, 177, 120, 63, 33, 178, 22, 154, 65,
+	132, 13, 145, 139, 140, 141, 142, 143, 144, 67,
+	101, 15, 16, 156, 172, 161, 69, 3, 8, 8,
+	169, 79, 150, 61, 66, 167, 168, 171, 170, 162,
+	89, 90, 70, 71, 148, 81, 82, 78, 80, 155,
+	149, 73, 74, 75, 76, 72, 77, 111, 83, 84,
+	86, 87, 88, 85, 174, 175, 68, 179, 86, 87,
+	88, 211, 181, 182, 180, 184, 151, 183, 185, 173,
+	79, 103, 218, 213, 188, 189, 198, 190, 92, 91,
+	197, 70, 71, 176, 81, 82, 78, 80, 196, 201,
+	73, 74, 75, 76, 72, 77, 200, 212, 79, 116,
+	216, 147, 121, 208, 209, 119, 117, 210, 105, 70,
+	71, 104, 81, 82, 78, 80, 214, 215, 73, 74,
+	75, 76, 72, 77, 25, 95, 79, 199, 220, 83,
+	84, 86, 87, 88, 85, 94, 153, 70, 71, 108,
+	81, 82, 78, 80, 42, 41, 73, 74, 75, 76,
+	72, 77, 79, 40, 207, 37, 36, 56, 18, 60,
+	194, 118, 24, 70, 71, 23, 81, 82, 78, 80,
+	79, 206, 73, 74, 75, 76, 72, 77, 28, 26,
+	14, 70, 71, 7, 81, 82, 78, 80, 79, 205,
+	73, 74, 75, 76, 72, 77, 62, 21, 204, 70,
+	71, 12, 81, 82, 78, 80, 6, 5, 73, 74,
+	75, 76, 72, 77, 79, 203, 17, 10, 4, 1,
+	0, 0, 0, 0, 0, 70, 71, 0, 81, 82,
+	78, 80, 0, 0, 73, 74, 75, 76, 72, 77,
+	0, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 70, 71, 0, 81, 82,
+	78, 80, 0, 79, 73, 74, 75, 76, 72, 77,
+	193, 0, 0, 0, 70, 71, 0, 81, 82, 78,
+	80, 0, 0, 73, 74, 75, 76, 72, 77, 79,
+	0, 0, 0, 0, 0, 109, 154, 0, 0, 0,
+	70, 71, 0, 81, 82, 78, 80, 0, 79, 73,
+	74, 75, 76, 72, 77, 0, 0, 0, 0, 70,
+	71, 0, 81, 82, 78, 80, 79, 0, 73, 74,
+	75, 76, 72, 77, 0, 0, 0, 70, 71, 0,
+	81, 82, 78, 80, 79, 0, 73, 74, 75, 76,
+	72, 77, 0, 0, 0, 70, 79, 0, 81, 82,
+	78, 80, 0, 0, 73, 74, 75, 76, 72, 77,
+	81, 82, 78, 80, 0, 0, 73, 74, 75, 76,
+	72, 77, 43, 44, 45, 46, 47, 38, 52, 0,
+	49, 0, 0, 48, 0, 0, 0, 35, 159, 43,
+	44, 45, 46, 47, 38, 52, 0, 49, 202, 0,
+	48, 0, 0, 0, 35, 0, 0, 0, 53, 54,
+	0, 0, 0, 50, 0, 55, 0, 0, 0, 51,
+	160, 0, 0, 34, 0, 53, 54, 0, 0, 0,
+	50, 0, 55, 0, 0, 0, 51, 0, 0, 0,
+	34, 43, 44, 45, 46, 47, 38, 52, 0, 49,
+	0, 0, 48, 0, 146, 0, 35, 43, 44, 45,
+	46, 47, 38, 52, 0, 49, 0, 0, 48, 0,
+	0, 0, 35, 29, 0, 0, 0, 53, 54, 0,
+	0, 0, 50, 0, 55, 0, 0, 0, 51, 0,
+	0, 0, 34, 53, 54, 0, 0, 0, 50, 0,
+	55, 0, 0, 0, 51, 0, 0, 0, 34, 43,
+	44, 45, 46, 47, 38, 52, 0, 49, 0, 0,
+	48, 0, 0, 0, 35, 0, 43, 44, 45, 46,
+	47, 38, 52, 0, 49, 0, 0, 48, 0, 0,
+	0, 35, 0, 0, 0, 53, 54, 8, 0, 0,
+	50, 0, 55, 109, 0, 0, 51, 0, 0, 0,
+	34, 0, 53, 54, 0, 0, 0, 50, 0, 55,
+	0, 0, 0, 51, 0, 0, 0, 34, 43, 44,
+	45, 46, 47, 38, 52, 0, 49, 0, 0, 48,
+	0, 0, 0, 35, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 53, 54, 0, 0, 0, 50,
+	0, 55, 0, 0, 0, 51, 0, 0, 0, 34,
 }
 var yyPact = []int{
 
-	-1, -1000, -1000, -6, -1000, -3, 140, -1000, -15, -8,
-	-1000, 157, -1000, -1000, -1000, -10, -1000, 157, -1000, -1000,
-	-1000, -1000, -1000, 66, -1000, -1000, -1000, -1000, 61, 157,
-	109, 54, -1000, -9, 157, 157, -1000, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, -1000,
-	-1000, -1000, 18, 46, 16, 48, 45, -18, -25, 56,
-	-1000, 157, -1000, -1000, 27, -11, -1000, -1000, -1000, -1000,
-	72, 37, 19, 19, 19, 19, 19, 19, -1000, 61,
-	157, -1000, 157, -1000, -1000, -1000, -1000, 157, -1000, -1000,
-	-1000, -1000, -1000, -1000,
+	83, -1000, -1000, 84, 34, -1000, 62, 74, -1000, -1000,
+	-3, 32, 56, 204, 553, -1000, -1000, -1000, 17, 674,
+	674, 52, 674, 59, -1000, 88, -1000, -1000, 132, -1000,
+	80, 374, 201, -1000, 674, 674, 157, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 209, 674,
+	674, 622, 7, 191, 188, 605, -1000, 674, -1000, -1000,
+	123, 29, -1000, 22, -1000, 179, 186, 185, 553, 182,
+	674, 674, 674, 674, 674, 674, 674, 674, 674, 674,
+	674, 3, 1, 674, 674, 674, 674, 674, 674, -1000,
+	-1000, 537, 181, 108, 116, 95, 143, 356, 115, 356,
+	-39, -41, 468, 179, -7, -15, 10, 337, -28, 674,
+	-1000, 674, -1000, -1000, 674, 78, 150, -1000, 133, -1000,
+	-1000, -1000, 404, 392, -12, -12, -12, -12, -12, -12,
+	-31, -31, 120, -1000, -1000, -1000, -1000, -1000, -1000, 128,
+	128, 128, -1000, -1000, -1000, 69, 674, -1000, -1000, 209,
+	674, -1000, 140, 58, 674, 674, -1000, -1000, -1000, -44,
+	-1000, -47, -1000, 674, 674, -1000, 674, -1, -1000, 311,
+	-1000, 36, 168, 160, 156, 213, 674, -1000, 485, 292,
+	-1000, -1000, -1000, -1000, 262, -1000, -1000, -1000, 236, 218,
+	200, -1000, 674, 674, -1000, 674, -1000, -1000, -1000, 138,
+	201, 174, -1000, -1000, 153, 674, 674, -1000, 146, 374,
+	-1000, -1000, -1000, -23, 118, -25, -1000, 674, -1000, -1000,
+	374,
 }
 var yyPgo = []int{
 
-	0, 100, 32, 99, 98, 97, 96, 95, 94, 87,
-	86, 0, 1, 84, 82, 80, 5, 24, 79, 78,
-	4, 3, 2, 68,
+	0, 309, 48, 308, 307, 306, 297, 296, 291, 287,
+	286, 273, 270, 269, 14, 268, 1, 255, 252, 15,
+	251, 2, 250, 6, 249, 248, 247, 0, 9, 85,
+	246, 245, 243, 235, 234, 4, 3, 7, 229, 226,
+	225,
 }
 var yyR1 = []int{
 
-	0, 1, 2, 3, 6, 7, 9, 10, 10, 8,
-	8, 4, 4, 12, 12, 13, 13, 13, 5, 5,
-	5, 14, 15, 11, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 17, 17, 18,
-	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 22, 22, 21, 21, 23, 20, 20,
+	0, 1, 1, 2, 3, 6, 7, 11, 12, 12,
+	12, 13, 14, 14, 15, 15, 15, 8, 8, 17,
+	17, 18, 18, 18, 18, 20, 20, 20, 9, 9,
+	10, 10, 22, 22, 4, 4, 23, 23, 24, 24,
+	24, 5, 5, 5, 25, 26, 16, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 28, 28, 28, 28, 28,
+	28, 28, 29, 29, 29, 30, 30, 30, 30, 30,
+	30, 31, 31, 31, 31, 31, 31, 31, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 21, 21, 34,
+	34, 34, 34, 37, 37, 38, 36, 36, 39, 39,
+	35, 35, 40, 33, 33, 32, 32, 32, 19, 19,
 }
 var yyR2 = []int{
 
-	0, 1, 3, 1, 2, 2, 1, 1, 1, 0,
-	2, 0, 3, 1, 3, 1, 2, 2, 0, 1,
-	2, 2, 2, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 1, 2, 1, 1,
-	1, 1, 1, 2, 1, 2, 1, 1, 1, 3,
-	3, 3, 3, 1, 3, 1, 3, 3, 1, 3,
+	0, 1, 2, 3, 1, 4, 3, 1, 0, 1,
+	1, 1, 1, 3, 1, 1, 3, 0, 2, 1,
+	5, 1, 3, 3, 5, 1, 3, 4, 0, 2,
+	0, 4, 0, 2, 0, 3, 1, 3, 1, 2,
+	2, 0, 1, 2, 2, 2, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 5, 3, 3,
+	3, 3, 3, 3, 1, 3, 3, 3, 3, 3,
+	3, 1, 2, 2, 1, 1, 4, 6, 5, 5,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 3, 4, 3, 3, 3, 1, 3, 3,
+	5, 4, 6, 1, 2, 4, 1, 2, 4, 6,
+	1, 3, 3, 7, 7, 4, 3, 4, 1, 3,
 }
 var yyChk = []int{
 
-	-1000, -1, -2, -3, -6, -7, -9, 22, -4, 24,
-	-8, 23, -10, 20, -11, -16, -17, 34, -18, -19,
-	9, -20, 4, 19, 5, 6, 7, 8, 15, 12,
-	30, 10, -5, -14, 29, 25, -11, 18, 19, 20,
-	21, 32, 33, 39, 35, 36, 37, 38, 40, -17,
-	4, 5, -21, -23, 6, -22, -11, -11, -2, 11,
-	-15, 28, -11, -12, -13, -11, -16, -16, -16, -16,
-	-16, -16, -16, -16, -16, -16, -16, -16, 16, 14,
-	17, 13, 14, 31, 31, -20, -11, 14, 26, 27,
-	-21, -11, -22, -12,
+	-1000, -1, -2, 24, -3, -6, -7, -11, 25, -2,
+	-4, 34, -8, 29, -12, 27, 28, -5, -25, 39,
+	35, -9, 31, -17, -18, 10, -13, -14, -15, 20,
+	-16, -27, -28, -29, 55, 19, -30, -31, 9, -19,
+	-32, -33, -34, 4, 5, 6, 7, 8, 15, 12,
+	45, 51, 10, 40, 41, 47, -26, 38, -16, -23,
+	-24, -16, -10, 32, -16, 30, 26, 11, 14, 26,
+	53, 54, 66, 62, 63, 64, 65, 67, 58, 42,
+	59, 56, 57, 18, 19, 23, 20, 21, 22, -29,
+	-29, 12, 11, -35, -40, 6, -21, -27, -16, -27,
+	-16, -2, 51, 11, 10, 10, -37, -27, -38, 48,
+	-16, 14, 36, 37, 35, -19, 10, 10, -20, 10,
+	-14, 10, -27, -27, -27, -27, -27, -27, -27, -27,
+	-27, -27, -28, 9, 60, 61, 9, 60, 61, -28,
+	-28, -28, -28, -28, -28, -27, 17, 10, 16, 14,
+	17, 13, -36, -39, 30, 14, -36, 52, 52, 20,
+	52, -21, -19, 42, 42, 44, 50, -37, -37, -27,
+	-23, -21, 26, 26, 11, 12, 53, 13, 17, -27,
+	-35, -16, 13, -36, -27, -21, 52, 52, -27, -27,
+	-27, 44, 50, 49, -22, 33, 10, 10, 10, 4,
+	-28, -27, 13, 13, 26, 43, 43, 44, -27, -27,
+	-16, 13, 13, 10, -27, -27, 44, 46, 44, 44,
+	-27,
 }
 var yyDef = []int{
 
-	0, -2, 1, 11, 3, 9, 0, 6, 18, 0,
-	4, 0, 5, 7, 8, 23, 36, 0, 38, 39,
-	40, 41, 42, 0, 44, 46, 47, 48, 0, 0,
-	0, 58, 2, 19, 0, 0, 10, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 37,
-	43, 45, 0, 55, 0, 0, 53, 0, 0, 0,
-	20, 0, 21, 12, 13, 15, 24, 25, 26, 27,
-	28, 29, 30, 31, 32, 33, 34, 35, 49, 0,
-	0, 50, 0, 51, 52, 59, 22, 0, 16, 17,
-	56, 57, 54, 14,
+	0, -2, 1, 0, 34, 4, 17, 8, 7, 2,
+	41, 0, 28, 0, 0, 9, 10, 3, 42, 0,
+	0, 30, 0, 18, 19, 21, 6, 11, 12, 14,
+	15, 46, 64, 71, 0, 0, 74, 75, 81, 82,
+	83, 84, 85, 86, 87, 88, 89, 90, 0, 0,
+	0, 0, 118, 0, 0, 0, 43, 0, 44, 35,
+	36, 38, 5, 0, 29, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 0, 0, 0, 110, 0, 0, 46, 97, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
+	45, 0, 39, 40, 0, 0, 118, 22, 23, 25,
+	13, 16, 47, 48, 49, 50, 51, 52, 53, 54,
+	-2, -2, 0, 58, 60, 62, 59, 61, 63, 65,
+	66, 67, 68, 69, 70, 0, 0, 80, 91, 0,
+	0, 92, 0, 106, 0, 0, 94, 95, 96, 0,
+	116, 0, 119, 0, 0, 99, 0, 0, 104, 0,
+	37, 32, 0, 0, 0, 0, 0, 76, 0, 0,
+	111, 112, 93, 107, 0, 98, 115, 117, 0, 0,
+	0, 101, 0, 0, 31, 0, 20, 24, 26, 0,
+	57, 0, 78, 79, 0, 0, 0, 100, 0, 105,
+	33, 27, 77, 108, 0, 0, 102, 0, 113, 114,
+	109,
 }
 var yyTok1 = []int{
 
@@ -218,7 +386,9 @@ var yyTok2 = []int{
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
-	42,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68,
 }
 var yyTok3 = []int{
 	0,
@@ -449,48 +619,82 @@ yydefault:
 	switch yynt {
 
 	case 1:
-		//line unql.y:38
+		//line unql.y:45
 		{ 
 		logDebugGrammar("INPUT") 
 	}
 	case 2:
-		//line unql.y:43
+		//line unql.y:49
 		{
-		logDebugGrammar("SELECT_STMT")
+		logDebugGrammar("INPUT - EXPLAIN")
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.Explain = true
+		default:
+			logDebugGrammar("This statement does not support EXPLAIN")
+		}
 	}
 	case 3:
-		//line unql.y:48
+		//line unql.y:60
+		{
+		logDebugGrammar("SELECT_STMT")
+	}
+	case 4:
+		//line unql.y:65
 		{ 
 		logDebugGrammar("SELECT_COMPOUND") 
 	}
-	case 4:
-		//line unql.y:53
+	case 5:
+		//line unql.y:70
 		{ 
 		logDebugGrammar("SELECT_CORE")
 	}
-	case 5:
-		//line unql.y:58
+	case 6:
+		//line unql.y:75
 		{
 		logDebugGrammar("SELECT_SELECT")
 	}
-	case 6:
-		//line unql.y:63
+	case 7:
+		//line unql.y:80
 		{ 
 		logDebugGrammar("SELECT_SELECT_HEAD")
 		if parsingStatement == nil {
 			parsingStatement = ast.NewSelectStatement()
 		}
 	}
-	case 7:
-		//line unql.y:71
-		{ 
-		logDebugGrammar("SELECT SELECT TAIL - STAR")
-	}
 	case 8:
-		//line unql.y:74
-		{ 
-		logDebugGrammar("SELECT SELECT TAIL - EXPR")
-		select_part := parsingStack.Pop().(ast.Expression)
+		//line unql.y:89
+		{
+		logDebugGrammar("SELECT SELECT QUALIFIER - EMPTY")
+	}
+	case 9:
+		//line unql.y:93
+		{
+		logDebugGrammar("SELECT SELECT QUALIFIER - DISTINCT")
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.Distinct = true
+		default:
+			logDebugGrammar("This statement does not support DISTINCT")
+		}
+	}
+	case 10:
+		//line unql.y:103
+		{
+		logDebugGrammar("SELECT SELECT QUALIFIER - UNIQUE")
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.Distinct = true
+		default:
+			logDebugGrammar("This statement does not support UNIQUE")
+		}
+	}
+	case 11:
+		//line unql.y:114
+		{
+		logDebugGrammar("SELECT SELECT TAIL - RESULT LIST")
+		select_part := parsingStack.Pop().(ast.ResultExpressionList)
+		select_part.AssignDefaultNames()
 		switch parsingStatement := parsingStatement.(type) {
 		case *ast.SelectStatement:
 			parsingStatement.Select = select_part
@@ -499,13 +703,126 @@ yydefault:
 			logDebugGrammar("This statement does not support SELECT")
 		}
 	}
-	case 9:
-		//line unql.y:88
+	case 12:
+		//line unql.y:129
+		{
+		logDebugGrammar("RESULT LIST - RESULT")
+		result_list := make(ast.ResultExpressionList, 0)
+		result_list = append(result_list, parsingStack.Pop().(*ast.ResultExpression))
+		parsingStack.Push(result_list)
+	}
+	case 13:
+		//line unql.y:136
+		{
+		logDebugGrammar("RESULT LIST - RESULT COMMA RESULT LIST")
+		rest := parsingStack.Pop().(ast.ResultExpressionList)
+		last := parsingStack.Pop()
+		new_list := make(ast.ResultExpressionList, 0, len(rest) + 1)
+		new_list = append(new_list, last.(*ast.ResultExpression))
+		for _, v := range rest {
+			new_list = append(new_list, v)
+		}
+		parsingStack.Push(new_list)
+	}
+	case 14:
+		//line unql.y:149
+		{
+		logDebugGrammar("RESULT - STAR")
+		parsingStack.Push(ast.NewStarResultExpression())
+	}
+	case 15:
+		//line unql.y:154
+		{
+		logDebugGrammar("RESULT - EXPR")
+		expr_part := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewResultExpression(expr_part))
+	}
+	case 16:
+		//line unql.y:160
+		{
+		logDebugGrammar("RESULT - EXPR AS %s", yyS[yypt-0].s)
+		expr_part := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewResultExpressionWithAlias(expr_part, yyS[yypt-0].s))
+	}
+	case 17:
+		//line unql.y:167
+		{
+		logDebugGrammar("SELECT FROM - EMPTY")
+	}
+	case 18:
+		//line unql.y:171
+		{
+		logDebugGrammar("SELECT FROM - DATASOURCE LIST")
+		data_sources := parsingStack.Pop().([]ast.DataSource)
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.From = data_sources
+		default:
+			logDebugGrammar("This statement does not support FROM")
+		}
+	}
+	case 19:
+		//line unql.y:183
+		{
+		logDebugGrammar("DATASOURCE LIST - DATASOURCE")
+		data_source := parsingStack.Pop().(ast.DataSource)
+		parsingStack.Push([]ast.DataSource{data_source})
+	}
+	case 20:
+		//line unql.y:189
+		{
+		logDebugGrammar("DATASOURCE LIST - OVER AS %s", yyS[yypt-0].s)
+		over_path := parsingStack.Pop().(*ast.Property)
+		data_sources := parsingStack.Pop().([]ast.DataSource)
+		data_sources = append(data_sources, ast.NewOverDataSource(over_path, yyS[yypt-0].s))
+		parsingStack.Push(data_sources)
+	}
+	case 21:
+		//line unql.y:198
+		{
+		logDebugGrammar("DATASOURCE - %s", yyS[yypt-0].s)
+		parsingStack.Push(ast.NewNamedDataSource(yyS[yypt-0].s))
+	}
+	case 22:
+		//line unql.y:203
+		{
+		logDebugGrammar("DATASOURCE - %s AS %s", yyS[yypt-2].s, yyS[yypt-0].s)
+		parsingStack.Push(ast.NewNamedDataSourceWithAlias(yyS[yypt-2].s, yyS[yypt-0].s))
+	}
+	case 23:
+		//line unql.y:208
+		{
+		logDebugGrammar("DATASOURCE - %s.%s", yyS[yypt-2].s, yyS[yypt-0].s)
+		parsingStack.Push(ast.NewNamedDataSourceWithPath(yyS[yypt-2].s, yyS[yypt-0].s, ""))
+	}
+	case 24:
+		//line unql.y:213
+		{
+		logDebugGrammar("DATASOURCE - %s.%s AS %s", yyS[yypt-4].s, yyS[yypt-2].s, yyS[yypt-0].s)
+		parsingStack.Push(ast.NewNamedDataSourceWithPath(yyS[yypt-4].s, yyS[yypt-2].s, yyS[yypt-0].s))
+	}
+	case 25:
+		//line unql.y:219
+		{
+		yyVAL.s = yyS[yypt-0].s
+	}
+	case 26:
+		//line unql.y:223
+		{
+		yyVAL.s = yyS[yypt-2].s + "." + yyS[yypt-0].s
+	}
+	case 27:
+		//line unql.y:227
+		{
+		yyVAL.s = fmt.Sprintf("%s[%d]", yyS[yypt-3].s, yyS[yypt-1].n)
+	}
+	case 28:
+		//line unql.y:232
 		{ 
 		logDebugGrammar("SELECT WHERE - EMPTY")
 	}
-	case 10:
-		//line unql.y:92
+	case 29:
+		//line unql.y:236
 		{
 		logDebugGrammar("SELECT WHERE - EXPR")
 		where_part := parsingStack.Pop().(ast.BooleanExpression)
@@ -516,23 +833,57 @@ yydefault:
 			logDebugGrammar("This statement does not support WHERE")
 		}
 	}
-	case 12:
-		//line unql.y:106
+	case 30:
+		//line unql.y:248
+		{
+		logDebugGrammar("SELECT GROUP - EMPTY")
+	}
+	case 31:
+		//line unql.y:252
+		{
+		logDebugGrammar("SELECT GROUP - EXPR_LIST")
+		group_by := parsingStack.Pop().([]ast.Expression)
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.GroupBy = group_by
+		default:
+			logDebugGrammar("This statement does not support GROUP BY")
+		}
+	}
+	case 32:
+		//line unql.y:264
+		{
+		logDebugGrammar("SELECT HAVING - EMPTY")
+	}
+	case 33:
+		//line unql.y:268
+		{
+		logDebugGrammar("SELECT HAVING - EXPR")
+		having_part := parsingStack.Pop().(ast.BooleanExpression)
+		switch parsingStatement := parsingStatement.(type) {
+		case *ast.SelectStatement:
+			parsingStatement.Having = having_part
+		default:
+			logDebugGrammar("This statement does not support HAVING")
+		}
+	}
+	case 35:
+		//line unql.y:282
 		{
 		
 	}
-	case 13:
-		//line unql.y:112
+	case 36:
+		//line unql.y:288
 		{
 		
 	}
-	case 14:
-		//line unql.y:116
+	case 37:
+		//line unql.y:292
 		{
 		
 	}
-	case 15:
-		//line unql.y:121
+	case 38:
+		//line unql.y:297
 		{ 
 		thisExpression := ast.NewSortExpression(parsingStack.Pop().(ast.Expression), true)
 		switch parsingStatement := parsingStatement.(type) {
@@ -542,8 +893,8 @@ yydefault:
 			logDebugGrammar("This statement does not support ORDER BY")
 		}
 	}
-	case 16:
-		//line unql.y:131
+	case 39:
+		//line unql.y:307
 		{ 
 		thisExpression := ast.NewSortExpression(parsingStack.Pop().(ast.Expression), true)
 		switch parsingStatement := parsingStatement.(type) {
@@ -553,8 +904,8 @@ yydefault:
 			logDebugGrammar("This statement does not support ORDER BY")
 		}
 	}
-	case 17:
-		//line unql.y:141
+	case 40:
+		//line unql.y:317
 		{ 
 		thisExpression := ast.NewSortExpression(parsingStack.Pop().(ast.Expression), false)
 		switch parsingStatement := parsingStatement.(type) {
@@ -564,23 +915,23 @@ yydefault:
 			logDebugGrammar("This statement does not support ORDER BY")
 		}
 	}
-	case 18:
-		//line unql.y:152
+	case 41:
+		//line unql.y:328
 		{
 		
 	}
-	case 19:
-		//line unql.y:156
+	case 42:
+		//line unql.y:332
 		{
 		
 	}
-	case 20:
-		//line unql.y:160
+	case 43:
+		//line unql.y:336
 		{
 		
 	}
-	case 21:
-		//line unql.y:166
+	case 44:
+		//line unql.y:342
 		{
 		thisExpression := parsingStack.Pop()
 		switch thisExpression := thisExpression.(type) {
@@ -595,8 +946,8 @@ yydefault:
 			logDebugGrammar("limit must be literal integer")
 		}
 	}
-	case 22:
-		//line unql.y:182
+	case 45:
+		//line unql.y:358
 		{ 
 		thisExpression := parsingStack.Pop()
 		switch thisExpression := thisExpression.(type) {
@@ -611,226 +962,399 @@ yydefault:
 			logDebugGrammar("offset must be literal integer")
 		}
 	}
-	case 23:
-		//line unql.y:198
+	case 46:
+		//line unql.y:374
 		{
 		logDebugGrammar("EXPRESSION")
 	}
-	case 24:
-		//line unql.y:203
+	case 47:
+		//line unql.y:379
 		{
-		logDebugGrammar("EXPR - PLUS")
+		logDebugGrammar("EXPR - AND")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewPlusOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewAndOperator([]ast.BooleanExpression{left.(ast.BooleanExpression), right.(ast.BooleanExpression)}) 
 		parsingStack.Push(thisExpression)
 	}
-	case 25:
-		//line unql.y:211
+	case 48:
+		//line unql.y:387
 		{
-		logDebugGrammar("EXPR - MINUS")
+		logDebugGrammar("EXPR - OR")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewSubtractOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewOrOperator([]ast.BooleanExpression{left.(ast.BooleanExpression), right.(ast.BooleanExpression)}) 
 		parsingStack.Push(thisExpression)
 	}
-	case 26:
-		//line unql.y:219
+	case 49:
+		//line unql.y:395
 		{
-		logDebugGrammar("EXPR - MULT")
+		logDebugGrammar("EXPR - EQ")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewMultiplyOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewEqualToOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 27:
-		//line unql.y:227
+	case 50:
+		//line unql.y:403
 		{
-		logDebugGrammar("EXPR - DIV")
+		logDebugGrammar("EXPR - LT")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewDivideOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewLessThanOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 28:
-		//line unql.y:235
+	case 51:
+		//line unql.y:411
 		{
-		logDebugGrammar("EXPR - AND")
+		logDebugGrammar("EXPR - LTE")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewAndOperator([]ast.BooleanExpression{left.(ast.BooleanExpression), right.(ast.BooleanExpression)}) 
+		thisExpression := ast.NewLessThanOrEqualOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 29:
-		//line unql.y:243
+	case 52:
+		//line unql.y:419
 		{
-		logDebugGrammar("EXPR - OR")
+		logDebugGrammar("EXPR - GT")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewOrOperator([]ast.BooleanExpression{left.(ast.BooleanExpression), right.(ast.BooleanExpression)}) 
+		thisExpression := ast.NewGreaterThanOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 30:
-		//line unql.y:251
+	case 53:
+		//line unql.y:427
 		{
-		logDebugGrammar("EXPR - EQ")
+		logDebugGrammar("EXPR - GTE")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewEqualToOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewGreaterThanOrEqualOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 31:
-		//line unql.y:259
+	case 54:
+		//line unql.y:435
 		{
-		logDebugGrammar("EXPR - LT")
+		logDebugGrammar("EXPR - NE")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewLessThanOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewNotEqualToOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 32:
-		//line unql.y:267
+	case 55:
+		//line unql.y:443
 		{
-		logDebugGrammar("EXPR - LTE")
+		logDebugGrammar("EXPR - LIKE")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewLessThanOrEqualOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewLikeOperator(left.(ast.Expression), right.(ast.Expression))
 		parsingStack.Push(thisExpression)
 	}
-	case 33:
-		//line unql.y:275
+	case 56:
+		//line unql.y:451
 		{
-		logDebugGrammar("EXPR - GT")
+		logDebugGrammar("EXPR - IN")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewGreaterThanOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewInOperator(left.(ast.Expression), right.(ast.Expression))
 		parsingStack.Push(thisExpression)
 	}
-	case 34:
-		//line unql.y:283
+	case 57:
+		//line unql.y:459
 		{
-		logDebugGrammar("EXPR - GTE")
+		logDebugGrammar("EXPR - BETWEEN")
+		high := parsingStack.Pop()
+		low := parsingStack.Pop()
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewBetweenOperator(operand.(ast.Expression), low.(ast.Expression), high.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 58:
+		//line unql.y:468
+		{
+		logDebugGrammar("EXPR - IS NULL")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsNullOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 59:
+		//line unql.y:475
+		{
+		logDebugGrammar("EXPR - IS NOT NULL")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsNotNullOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 60:
+		//line unql.y:482
+		{
+		logDebugGrammar("EXPR - IS MISSING")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsMissingOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 61:
+		//line unql.y:489
+		{
+		logDebugGrammar("EXPR - IS NOT MISSING")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsNotMissingOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 62:
+		//line unql.y:496
+		{
+		logDebugGrammar("EXPR - IS VALUED")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsNotNullOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 63:
+		//line unql.y:503
+		{
+		logDebugGrammar("EXPR - IS NOT VALUED")
+		operand := parsingStack.Pop()
+		thisExpression := ast.NewIsNullOperator(operand.(ast.Expression))
+		parsingStack.Push(thisExpression)
+	}
+	case 64:
+		//line unql.y:510
+		{
+		// the bounds of BETWEEN are arithmetic so its AND is not a conjunction
+}
+	case 65:
+		//line unql.y:516
+		{
+		logDebugGrammar("EXPR - PLUS")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewGreaterThanOrEqualOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewPlusOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 35:
-		//line unql.y:291
+	case 66:
+		//line unql.y:524
 		{
-		logDebugGrammar("EXPR - NE")
+		logDebugGrammar("EXPR - MINUS")
 		right := parsingStack.Pop()
 		left := parsingStack.Pop()
-		thisExpression := ast.NewNotEqualToOperator(left.(ast.Expression), right.(ast.Expression)) 
+		thisExpression := ast.NewSubtractOperator(left.(ast.Expression), right.(ast.Expression)) 
 		parsingStack.Push(thisExpression)
 	}
-	case 36:
-		//line unql.y:299
+	case 67:
+		//line unql.y:532
+		{
+		logDebugGrammar("EXPR - CONCAT")
+		right := parsingStack.Pop()
+		left := parsingStack.Pop()
+		thisExpression := ast.NewConcatOperator(left.(ast.Expression), right.(ast.Expression)) 
+		parsingStack.Push(thisExpression)
+	}
+	case 68:
+		//line unql.y:540
+		{
+		logDebugGrammar("EXPR - MULT")
+		right := parsingStack.Pop()
+		left := parsingStack.Pop()
+		thisExpression := ast.NewMultiplyOperator(left.(ast.Expression), right.(ast.Expression)) 
+		parsingStack.Push(thisExpression)
+	}
+	case 69:
+		//line unql.y:548
+		{
+		logDebugGrammar("EXPR - DIV")
+		right := parsingStack.Pop()
+		left := parsingStack.Pop()
+		thisExpression := ast.NewDivideOperator(left.(ast.Expression), right.(ast.Expression)) 
+		parsingStack.Push(thisExpression)
+	}
+	case 70:
+		//line unql.y:556
+		{
+		logDebugGrammar("EXPR - MOD")
+		right := parsingStack.Pop()
+		left := parsingStack.Pop()
+		thisExpression := ast.NewModuloOperator(left.(ast.Expression), right.(ast.Expression)) 
+		parsingStack.Push(thisExpression)
+	}
+	case 71:
+		//line unql.y:564
 		{
 		
 	}
-	case 37:
-		//line unql.y:305
+	case 72:
+		//line unql.y:570
 		{
 		logDebugGrammar("EXPR - NOT")
 	}
-	case 38:
-		//line unql.y:309
+	case 73:
+		//line unql.y:574
 		{
-		
+		logDebugGrammar("EXPR - MINUS")
+		operand := parsingStack.Pop()
+		switch operand := operand.(type) {
+		case *ast.LiteralNumber:
+			// keep negative numbers literal so they can be index keys
+		parsingStack.Push(ast.NewLiteralNumber(-operand.Value))
+		default:
+			parsingStack.Push(ast.NewNegateOperator(operand.(ast.Expression)))
+		}
 	}
-	case 39:
-		//line unql.y:314
+	case 74:
+		//line unql.y:586
+		{
+		// a subscript following a comprehension applies to its last condition
+}
+	case 75:
+		//line unql.y:591
 		{
 		logDebugGrammar("SUFFIX_EXPR")
 	}
-	case 40:
-		//line unql.y:319
+	case 76:
+		//line unql.y:595
+		{
+		logDebugGrammar("SUFFIX_EXPR - []")
+		index := parsingStack.Pop().(ast.Expression)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(newSubscript(operand, index))
+	}
+	case 77:
+		//line unql.y:602
+		{
+		logDebugGrammar("SUFFIX_EXPR - [:]")
+		end := parsingStack.Pop().(ast.Expression)
+		start := parsingStack.Pop().(ast.Expression)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewSliceOperator(operand, start, end))
+	}
+	case 78:
+		//line unql.y:610
+		{
+		logDebugGrammar("SUFFIX_EXPR - [start:]")
+		start := parsingStack.Pop().(ast.Expression)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewSliceOperator(operand, start, nil))
+	}
+	case 79:
+		//line unql.y:617
+		{
+		logDebugGrammar("SUFFIX_EXPR - [:end]")
+		end := parsingStack.Pop().(ast.Expression)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewSliceOperator(operand, nil, end))
+	}
+	case 80:
+		//line unql.y:624
+		{
+		logDebugGrammar("SUFFIX_EXPR - .")
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(newField(operand, yyS[yypt-0].s))
+	}
+	case 81:
+		//line unql.y:631
 		{
 		logDebugGrammar("NULL")
 		thisExpression := ast.NewLiteralNull()
 		parsingStack.Push(thisExpression)
 	}
-	case 41:
-		//line unql.y:325
+	case 82:
+		//line unql.y:637
 		{
 	
 	}
-	case 42:
-		//line unql.y:338
-		{ 
-		thisExpression := ast.NewLiteralNumber(float64(yyS[yypt-0].n))
-		parsingStack.Push(thisExpression)
+	case 83:
+		//line unql.y:641
+		{
+	
 	}
-	case 43:
-		//line unql.y:343
+	case 84:
+		//line unql.y:645
 		{
-		thisExpression := ast.NewLiteralNumber(float64(-yyS[yypt-1].n))
-		parsingStack.Push(thisExpression)
+	
 	}
-	case 44:
-		//line unql.y:348
+	case 85:
+		//line unql.y:649
 		{
-		thisExpression := ast.NewLiteralNumber(yyS[yypt-0].f)
+	
+	}
+	case 86:
+		//line unql.y:653
+		{ 
+		thisExpression := ast.NewLiteralNumber(float64(yyS[yypt-0].n))
 		parsingStack.Push(thisExpression)
 	}
-	case 45:
-		//line unql.y:353
+	case 87:
+		//line unql.y:658
 		{
-		thisExpression := ast.NewLiteralNumber(-yyS[yypt-1].f)
+		thisExpression := ast.NewLiteralNumber(yyS[yypt-0].f)
 		parsingStack.Push(thisExpression)
 	}
-	case 46:
-		//line unql.y:358
+	case 88:
+		//line unql.y:663
 		{
 		thisExpression := ast.NewLiteralString(yyS[yypt-0].s) 
 		parsingStack.Push(thisExpression)
 	}
-	case 47:
-		//line unql.y:363
+	case 89:
+		//line unql.y:668
 		{
 		thisExpression := ast.NewLiteralBool(true) 
 		parsingStack.Push(thisExpression)
 	}
-	case 48:
-		//line unql.y:368
+	case 90:
+		//line unql.y:673
 		{
 		thisExpression := ast.NewLiteralBool(false) 
 		parsingStack.Push(thisExpression)
 	}
-	case 49:
-		//line unql.y:373
+	case 91:
+		//line unql.y:678
 		{
 		logDebugGrammar("ATOM - {}")
 	}
-	case 50:
-		//line unql.y:377
+	case 92:
+		//line unql.y:682
 		{
 	    logDebugGrammar("ATOM - []")
 		exp_list := parsingStack.Pop().([]ast.Expression)
 		thisExpression := ast.NewLiteralArray(exp_list)
 		parsingStack.Push(thisExpression)
 	}
-	case 51:
-		//line unql.y:384
+	case 93:
+		//line unql.y:689
+		{
+		logDebugGrammar("ATOM - [expr OVER]")
+		overs := parsingStack.Pop().([]*ast.ComprehensionOver)
+		output := parsingStack.Pop().(ast.Expression)
+		thisExpression := ast.NewArrayComprehension(output, overs)
+		parsingStack.Push(thisExpression)
+	}
+	case 94:
+		//line unql.y:697
+		{
+		logDebugGrammar("ATOM - FIRST expr OVER")
+		overs := parsingStack.Pop().([]*ast.ComprehensionOver)
+		output := parsingStack.Pop().(ast.Expression)
+		thisExpression := ast.NewFirstComprehension(output, overs)
+		parsingStack.Push(thisExpression)
+	}
+	case 95:
+		//line unql.y:705
 		{
 		
 	}
-	case 52:
-		//line unql.y:388
+	case 96:
+		//line unql.y:709
 		{
 		
 	}
-	case 53:
-		//line unql.y:393
+	case 97:
+		//line unql.y:714
 		{
 		logDebugGrammar("EXPRESSION_LIST - EXPRESSION")
 		exp_list := make([]ast.Expression, 0)
 		exp_list = append(exp_list, parsingStack.Pop().(ast.Expression))
 		parsingStack.Push(exp_list)
 	}
-	case 54:
-		//line unql.y:400
+	case 98:
+		//line unql.y:721
 		{ 
 		logDebugGrammar("EXPRESSION_LIST - EXPRESSION COMMA EXPRESSION_LIST")
 		rest := parsingStack.Pop().([]ast.Expression)
@@ -842,13 +1366,101 @@ yydefault:
 		}
 		parsingStack.Push(new_list)
 	}
-	case 55:
-		//line unql.y:413
+	case 99:
+		//line unql.y:734
+		{
+		logDebugGrammar("CASE_EXPR - CASE WHEN END")
+		whenThens := parsingStack.Pop().([]*ast.WhenThen)
+		parsingStack.Push(ast.NewCaseOperator(nil, whenThens, nil))
+	}
+	case 100:
+		//line unql.y:740
+		{
+		logDebugGrammar("CASE_EXPR - CASE WHEN ELSE END")
+		elseExpression := parsingStack.Pop().(ast.Expression)
+		whenThens := parsingStack.Pop().([]*ast.WhenThen)
+		parsingStack.Push(ast.NewCaseOperator(nil, whenThens, elseExpression))
+	}
+	case 101:
+		//line unql.y:747
+		{
+		logDebugGrammar("CASE_EXPR - CASE expr WHEN END")
+		whenThens := parsingStack.Pop().([]*ast.WhenThen)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewCaseOperator(operand, whenThens, nil))
+	}
+	case 102:
+		//line unql.y:754
+		{
+		logDebugGrammar("CASE_EXPR - CASE expr WHEN ELSE END")
+		elseExpression := parsingStack.Pop().(ast.Expression)
+		whenThens := parsingStack.Pop().([]*ast.WhenThen)
+		operand := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewCaseOperator(operand, whenThens, elseExpression))
+	}
+	case 103:
+		//line unql.y:763
+		{
+		logDebugGrammar("CASE_WHENS - CASE_WHEN")
+		whenThen := parsingStack.Pop().(*ast.WhenThen)
+		parsingStack.Push([]*ast.WhenThen{whenThen})
+	}
+	case 104:
+		//line unql.y:769
+		{
+		logDebugGrammar("CASE_WHENS - CASE_WHEN CASE_WHENS")
+		rest := parsingStack.Pop().([]*ast.WhenThen)
+		first := parsingStack.Pop().(*ast.WhenThen)
+		parsingStack.Push(append([]*ast.WhenThen{first}, rest...))
+	}
+	case 105:
+		//line unql.y:777
+		{
+		logDebugGrammar("CASE_WHEN - WHEN expr THEN expr")
+		then := parsingStack.Pop().(ast.Expression)
+		when := parsingStack.Pop().(ast.Expression)
+		parsingStack.Push(ast.NewWhenThen(when, then))
+	}
+	case 106:
+		//line unql.y:785
+		{
+		// a nested comprehension takes any OVER clauses following it
+	logDebugGrammar("COMPREHENSION_OVERS - COMPREHENSION_OVER")
+		over := parsingStack.Pop().(*ast.ComprehensionOver)
+		parsingStack.Push([]*ast.ComprehensionOver{over})
+	}
+	case 107:
+		//line unql.y:792
+		{
+		logDebugGrammar("COMPREHENSION_OVERS - COMPREHENSION_OVER COMPREHENSION_OVERS")
+		rest := parsingStack.Pop().([]*ast.ComprehensionOver)
+		first := parsingStack.Pop().(*ast.ComprehensionOver)
+		parsingStack.Push(append([]*ast.ComprehensionOver{first}, rest...))
+	}
+	case 108:
+		//line unql.y:800
+		{
+		logDebugGrammar("COMPREHENSION_OVER - OVER expr AS %s", yyS[yypt-0].s)
+		over := parsingStack.Pop().(ast.Expression)
+		parsingVariables = append(parsingVariables, yyS[yypt-0].s)
+		parsingStack.Push(ast.NewComprehensionOver(over, yyS[yypt-0].s, nil))
+	}
+	case 109:
+		//line unql.y:807
+		{
+		logDebugGrammar("COMPREHENSION_OVER - OVER expr AS %s IF expr", yyS[yypt-2].s)
+		condition := parsingStack.Pop().(ast.BooleanExpression)
+		over := parsingStack.Pop().(ast.Expression)
+		parsingVariables = append(parsingVariables, yyS[yypt-2].s)
+		parsingStack.Push(ast.NewComprehensionOver(over, yyS[yypt-2].s, condition))
+	}
+	case 110:
+		//line unql.y:816
 		{
 		
 	}
-	case 56:
-		//line unql.y:417
+	case 111:
+		//line unql.y:820
 		{
 		last := parsingStack.Pop().(*ast.LiteralObject)
 		rest := parsingStack.Pop().(*ast.LiteralObject)
@@ -857,25 +1469,89 @@ yydefault:
 		}
 		parsingStack.Push(rest)
 	}
-	case 57:
-		//line unql.y:427
+	case 112:
+		//line unql.y:830
 		{  
 		thisKey := yyS[yypt-2].s
 		thisValue := parsingStack.Pop().(ast.Expression)
 		thisExpression := ast.NewLiteralObject(map[string]ast.Expression{thisKey: thisValue})
 		parsingStack.Push(thisExpression) 
 	}
-	case 58:
-		//line unql.y:435
+	case 113:
+		//line unql.y:838
+		{
+		logDebugGrammar("ANY %s IN expr SATISFIES expr END", yyS[yypt-5].s)
+		condition := parsingStack.Pop().(ast.BooleanExpression)
+		over := parsingStack.Pop().(ast.Expression)
+		parsingVariables = append(parsingVariables, yyS[yypt-5].s)
+		thisExpression := ast.NewCollectionAnyOperator(condition, over, yyS[yypt-5].s)
+		parsingStack.Push(thisExpression)
+	}
+	case 114:
+		//line unql.y:847
 		{
-		thisExpression := ast.NewProperty(yyS[yypt-0].s) 
+		logDebugGrammar("ALL %s IN expr SATISFIES expr END", yyS[yypt-5].s)
+		condition := parsingStack.Pop().(ast.BooleanExpression)
+		over := parsingStack.Pop().(ast.Expression)
+		parsingVariables = append(parsingVariables, yyS[yypt-5].s)
+		thisExpression := ast.NewCollectionAllOperator(condition, over, yyS[yypt-5].s)
+		parsingStack.Push(thisExpression)
+	}
+	case 115:
+		//line unql.y:857
+		{
+		logDebugGrammar("AGGREGATE - %s(*)", yyS[yypt-3].s)
+		thisExpression, err := ast.NewAggregateFunction(yyS[yypt-3].s, nil)
+		if err != nil {
+			yylex.Error(err.Error())
+		}
+		parsingStack.Push(thisExpression)
+	}
+	case 116:
+		//line unql.y:866
+		{
+		logDebugGrammar("FUNCTION - %s()", yyS[yypt-2].s)
+		thisExpression, err := ast.NewFunctionCall(yyS[yypt-2].s, []ast.Expression{})
+		if err != nil {
+			yylex.Error(err.Error())
+		}
+		parsingStack.Push(thisExpression)
+	}
+	case 117:
+		//line unql.y:875
+		{
+		operands := parsingStack.Pop().([]ast.Expression)
+		if len(operands) == 1 && ast.IsAggregateFunctionName(yyS[yypt-3].s) {
+			logDebugGrammar("AGGREGATE - %s(expr)", yyS[yypt-3].s)
+			thisExpression, err := ast.NewAggregateFunction(yyS[yypt-3].s, operands[0])
+			if err != nil {
+				yylex.Error(err.Error())
+			}
+			parsingStack.Push(thisExpression)
+		} else {
+			logDebugGrammar("FUNCTION - %s(expr_list)", yyS[yypt-3].s)
+			thisExpression, err := ast.NewFunctionCall(yyS[yypt-3].s, operands)
+			if err != nil {
+				yylex.Error(err.Error())
+			}
+			parsingStack.Push(thisExpression)
+		}
+	}
+	case 118:
+		//line unql.y:895
+		{
+		// a dot following an identifier continues the path of the property
+	thisExpression := ast.NewProperty(yyS[yypt-0].s) 
+		parsingProperties = append(parsingProperties, thisExpression)
 		parsingStack.Push(thisExpression) 
 	}
-	case 59:
-		//line unql.y:440
+	case 119:
+		//line unql.y:902
 		{
-		thisValue := parsingStack.Pop().(*ast.Property)
-		thisExpression := ast.NewProperty(yyS[yypt-2].s + "." + thisValue.Path)
+		// extend the path of the property already created
+	// so that every property is created (and tracked) once
+	thisExpression := parsingStack.Pop().(*ast.Property)
+		thisExpression.Path = yyS[yypt-2].s + "." + thisExpression.Path
 		parsingStack.Push(thisExpression)
 	}
 	}
@@ -0,0 +1,150 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// Distinct removes rows whose projected value is equal (by collation)
+// to the projected value of a row seen earlier, the first such row is kept
+//
+// if the rows arrive ordered by the projected values, duplicates are
+// adjacent and only the previous value must be remembered (streaming)
+// otherwise every distinct value seen so far is kept in memory (hash)
+type Distinct struct {
+	source        Operator
+	outputChannel OutputChannel
+	projection    ast.ResultExpressionList
+	ordered       bool
+}
+
+func NewDistinct(source Operator, projection ast.ResultExpressionList, ordered bool) *Distinct {
+	return &Distinct{
+		source:        source,
+		outputChannel: make(OutputChannel),
+		projection:    projection,
+		ordered:       ordered,
+	}
+}
+
+func (this *Distinct) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *Distinct) Run() {
+	defer close(this.outputChannel)
+
+	var last interface{}
+	first := true
+	seen := make(map[string]bool)
+
+	// start the source
+	go this.source.Run()
+	for row := range this.source.GetOutputChannel() {
+		value, err := this.projectedValue(row)
+		if err != nil {
+			log.Printf("Error evaluating projection: %v", err)
+			continue
+		}
+
+		if this.ordered {
+			if !first && ast.CollateJSON(last, value) == 0 {
+				continue
+			}
+			first = false
+			last = value
+		} else {
+			// values collating equal have the same collation key
+			// so both modes agree on which rows are duplicates
+			hash := string(ast.CollationKey(value))
+			if seen[hash] {
+				continue
+			}
+			seen[hash] = true
+		}
+
+		this.outputChannel <- row
+	}
+}
+
+// the whole row also holds the meta data of the document, which is
+// different for every document, so without a projection or with *
+// only the document itself (and any named results) is compared
+func (this *Distinct) projectedValue(row Output) (interface{}, error) {
+	switch row := row.(type) {
+	case datasource.Document:
+		if len(this.projection) == 0 {
+			return map[string]interface{}{ast.DOCUMENT_KEY: row[ast.DOCUMENT_KEY]}, nil
+		}
+		value, err := this.projection.Evaluate(ast.NewContext(row))
+		if err != nil || !this.projection.ContainsStar() {
+			return value, err
+		}
+		projected := value.(map[string]interface{})
+		rv := map[string]interface{}{ast.DOCUMENT_KEY: row[ast.DOCUMENT_KEY]}
+		for _, resultExpr := range this.projection {
+			if !resultExpr.Star {
+				rv[resultExpr.As] = projected[resultExpr.As]
+			}
+		}
+		return rv, nil
+	}
+	panic(fmt.Sprintf("Non-map rows not currently supported (saw %T)", row))
+}
+
+func (this *Distinct) Explain() map[string]interface{} {
+	mode := "hash"
+	if this.ordered {
+		mode = "streaming"
+	}
+	rv := map[string]interface{}{
+		"type":           "distinct",
+		"mode":           mode,
+		"expression":     this.projection,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+	if this.Source() != nil {
+		rv["source"] = this.Source().Explain()
+	}
+	return rv
+}
+func (this *Distinct) Cancel() {}
+
+func (this *Distinct) Cost() float64 {
+	sourceRows := this.source.EstimatedRows()
+	if this.ordered {
+		return float64(sourceRows) * CPU_COST
+	}
+	return float64(sourceRows) * MEMORY_COST
+}
+
+func (this *Distinct) EstimatedRows() int {
+	// FIXME without statistics about the projection
+	// assume every row could be distinct
+	return this.source.EstimatedRows()
+}
+
+func (this *Distinct) TotalCost() float64 {
+	return this.Cost() + this.source.TotalCost()
+}
+
+func (this *Distinct) String() string {
+	return OperatorToString(this)
+}
+
+func (this *Distinct) Source() Operator {
+	return this.source
+}
@@ -0,0 +1,68 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+func TestDistinctStar(t *testing.T) {
+
+	rows := []datasource.Document{
+		{"doc": map[string]interface{}{"type": "ale"}, "meta": map[string]interface{}{"id": "a"}},
+		{"doc": map[string]interface{}{"type": "stout"}, "meta": map[string]interface{}{"id": "b"}},
+		{"doc": map[string]interface{}{"type": "ale"}, "meta": map[string]interface{}{"id": "c"}},
+		{"doc": map[string]interface{}{"type": "ale", "abv": 5.0}, "meta": map[string]interface{}{"id": "d"}},
+	}
+
+	tests := []struct {
+		projection ast.ResultExpressionList
+		count      int
+	}{
+		{ast.ResultExpressionList{}, 3},
+		{ast.ResultExpressionList{ast.NewStarResultExpression()}, 3},
+		{ast.ResultExpressionList{ast.NewResultExpressionWithAlias(ast.NewProperty("doc.type"), "type")}, 2},
+		// named results are still compared alongside the document
+		{ast.ResultExpressionList{
+			ast.NewStarResultExpression(),
+			ast.NewResultExpressionWithAlias(ast.NewProperty("meta.id"), "id"),
+		}, 4},
+	}
+
+	for _, x := range tests {
+		results := runOperator(NewDistinct(newRowsOperator(rows), x.projection, false))
+		if len(results) != x.count {
+			t.Errorf("Expected %d distinct rows for %v, got %v", x.count, x.projection, results)
+		}
+	}
+
+}
+
+func TestDistinctModesAgree(t *testing.T) {
+
+	// canonically equivalent strings collate equal
+	rows := []datasource.Document{
+		{"doc": map[string]interface{}{"name": "café"}},
+		{"doc": map[string]interface{}{"name": "cafe\u0301"}},
+		{"doc": map[string]interface{}{"name": "cafe"}},
+	}
+
+	projection := ast.ResultExpressionList{ast.NewResultExpressionWithAlias(ast.NewProperty("doc.name"), "name")}
+	for _, ordered := range []bool{true, false} {
+		results := runOperator(NewDistinct(newRowsOperator(rows), projection, ordered))
+		if len(results) != 2 {
+			t.Errorf("Expected 2 distinct rows when ordered is %v, got %v", ordered, results)
+		}
+	}
+
+}
@@ -0,0 +1,197 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+type Group struct {
+	source        Operator
+	outputChannel OutputChannel
+	groupBy       []ast.Expression
+	aggregates    []ast.AggregateFunction
+}
+
+type group struct {
+	row          datasource.Document
+	accumulators map[string]ast.Accumulator
+}
+
+func NewGroup(source Operator, groupBy []ast.Expression, aggregates []ast.AggregateFunction) *Group {
+	return &Group{
+		source:        source,
+		outputChannel: make(OutputChannel),
+		groupBy:       groupBy,
+		aggregates:    aggregates,
+	}
+}
+
+func (this *Group) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *Group) Run() {
+	defer close(this.outputChannel)
+
+	// groups are hashed by the encoding of their key (see groupKeyHash)
+	// the slice preserves the order in which groups were first seen
+	groups := make(map[string]*group)
+	groupOrder := make([]*group, 0)
+
+	// start the source
+	go this.source.Run()
+DOCUMENT:
+	for row := range this.source.GetOutputChannel() {
+		var doc datasource.Document
+		var context ast.Context
+		switch row := row.(type) {
+		case datasource.Document:
+			doc = row
+			context = ast.NewContext(row)
+		default:
+			panic(fmt.Sprintf("Non-map rows not currently supported (saw %T)", row))
+		}
+
+		groupKey := make([]interface{}, 0, len(this.groupBy))
+		for _, expr := range this.groupBy {
+			value, err := expr.Evaluate(context)
+			if err != nil {
+				log.Printf("Error evaluating group by expression: %v", err)
+				continue DOCUMENT
+			}
+			groupKey = append(groupKey, value)
+		}
+		groupHash, err := groupKeyHash(groupKey)
+		if err != nil {
+			log.Printf("Error hashing group key: %v", err)
+			continue DOCUMENT
+		}
+
+		currentGroup, ok := groups[groupHash]
+		if !ok {
+			// a copy of the first row in the group is used to
+			// evaluate the non-aggregate expressions for the group
+			currentGroup = this.newGroup(copyRow(doc))
+			groups[groupHash] = currentGroup
+			groupOrder = append(groupOrder, currentGroup)
+		}
+
+		for key, accumulator := range currentGroup.accumulators {
+			err := accumulator.Accumulate(context)
+			if err != nil {
+				log.Printf("Error evaluating aggregate %v: %v", key, err)
+			}
+		}
+	}
+
+	// an aggregate query without GROUP BY always has exactly one group
+	if len(this.groupBy) == 0 && len(groupOrder) == 0 {
+		groupOrder = append(groupOrder, this.newGroup(datasource.Document{}))
+	}
+
+	// write the output
+	for _, currentGroup := range groupOrder {
+		aggregateValues := make(map[string]interface{}, len(currentGroup.accumulators))
+		for key, accumulator := range currentGroup.accumulators {
+			aggregateValues[key] = accumulator.Value()
+		}
+		currentGroup.row[ast.AGGREGATES_KEY] = aggregateValues
+		this.outputChannel <- currentGroup.row
+	}
+}
+
+// the JSON encoding of the group key, MISSING encodes as null so each
+// value is wrapped in an array and a MISSING value is an empty array
+func groupKeyHash(groupKey []interface{}) (string, error) {
+	wrapped := make([]interface{}, len(groupKey))
+	for i, value := range groupKey {
+		if value == ast.MISSING {
+			wrapped[i] = []interface{}{}
+		} else {
+			wrapped[i] = []interface{}{value}
+		}
+	}
+	rv, err := json.Marshal(wrapped)
+	if err != nil {
+		return "", err
+	}
+	return string(rv), nil
+}
+
+// a new row with the same top level values, so the source row is not changed
+func copyRow(row datasource.Document) datasource.Document {
+	rv := make(datasource.Document, len(row)+1)
+	for k, v := range row {
+		rv[k] = v
+	}
+	return rv
+}
+
+func (this *Group) newGroup(row datasource.Document) *group {
+	rv := group{
+		row:          row,
+		accumulators: make(map[string]ast.Accumulator, len(this.aggregates)),
+	}
+	for _, aggregate := range this.aggregates {
+		rv.accumulators[aggregate.Key()] = aggregate.NewAccumulator()
+	}
+	return &rv
+}
+
+func (this *Group) Explain() map[string]interface{} {
+	aggregates := make([]string, 0, len(this.aggregates))
+	for _, aggregate := range this.aggregates {
+		aggregates = append(aggregates, aggregate.Key())
+	}
+	rv := map[string]interface{}{
+		"type":           "group",
+		"by":             this.groupBy,
+		"aggregates":     aggregates,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+	if this.Source() != nil {
+		rv["source"] = this.Source().Explain()
+	}
+	return rv
+}
+func (this *Group) Cancel() {}
+
+func (this *Group) Cost() float64 {
+	sourceRows := this.source.EstimatedRows()
+	return float64(sourceRows) * CPU_COST
+}
+
+func (this *Group) EstimatedRows() int {
+	if len(this.groupBy) == 0 {
+		return 1
+	}
+	// FIXME without statistics about the group by expressions
+	// assume every row could be its own group
+	return this.source.EstimatedRows()
+}
+
+func (this *Group) TotalCost() float64 {
+	return this.Cost() + this.source.TotalCost()
+}
+
+func (this *Group) String() string {
+	return OperatorToString(this)
+}
+
+func (this *Group) Source() Operator {
+	return this.source
+}
@@ -0,0 +1,89 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+func TestGroupMissingAndNull(t *testing.T) {
+
+	rows := []datasource.Document{
+		{"doc": map[string]interface{}{"type": "beer"}},
+		{"doc": map[string]interface{}{"type": nil}},
+		{"doc": map[string]interface{}{}},
+		{"doc": map[string]interface{}{"type": nil}},
+		{"doc": map[string]interface{}{"type": "beer"}},
+		{"doc": map[string]interface{}{}},
+		{"doc": map[string]interface{}{}},
+	}
+
+	count := ast.NewCountAggregate(nil)
+	group := NewGroup(newRowsOperator(rows), []ast.Expression{ast.NewProperty("doc.type")}, []ast.AggregateFunction{count})
+	projection := ast.ResultExpressionList{
+		ast.NewResultExpressionWithAlias(ast.NewProperty("doc.type"), "type"),
+		ast.NewResultExpressionWithAlias(count, "count"),
+	}
+
+	results := runOperator(NewProject(group, projection))
+
+	expected := []Output{
+		map[string]interface{}{"type": "beer", "count": 2.0},
+		map[string]interface{}{"type": nil, "count": 2.0},
+		map[string]interface{}{"count": 3.0},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, got %v", expected, results)
+	}
+
+	// the source rows are not changed
+	for _, row := range rows {
+		if _, ok := row[ast.AGGREGATES_KEY]; ok {
+			t.Errorf("Expected source row %v without aggregates", row)
+		}
+	}
+
+}
+
+func TestGroupStarWithoutAggregates(t *testing.T) {
+
+	rows := []datasource.Document{
+		{"doc": map[string]interface{}{"type": "beer"}},
+		{"doc": map[string]interface{}{"type": "beer"}},
+	}
+
+	expected := map[string]interface{}{"doc": map[string]interface{}{"type": "beer"}}
+
+	for _, projection := range []ast.ResultExpressionList{
+		ast.ResultExpressionList{},
+		ast.ResultExpressionList{ast.NewStarResultExpression()},
+	} {
+		group := NewGroup(newRowsOperator(rows), []ast.Expression{ast.NewProperty("doc.type")}, []ast.AggregateFunction{})
+		results := runOperator(NewProject(group, projection))
+		if len(results) != 1 || !reflect.DeepEqual(toMap(results[0]), expected) {
+			t.Errorf("Expected %v for %v, got %v", expected, projection, results)
+		}
+	}
+
+}
+
+func toMap(output Output) map[string]interface{} {
+	switch output := output.(type) {
+	case datasource.Document:
+		return output
+	case map[string]interface{}:
+		return output
+	}
+	return nil
+}
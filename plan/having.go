@@ -0,0 +1,100 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// Having filters the groups produced by Group, unlike Filter
+// its expression may refer to aggregate values
+type Having struct {
+	source        Operator
+	outputChannel OutputChannel
+	having        ast.BooleanExpression
+}
+
+func NewHaving(source Operator, having ast.BooleanExpression) *Having {
+	return &Having{
+		source:        source,
+		outputChannel: make(OutputChannel),
+		having:        having,
+	}
+}
+
+func (this *Having) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *Having) Run() {
+	defer close(this.outputChannel)
+
+	// start the source
+	go this.source.Run()
+	for row := range this.source.GetOutputChannel() {
+		var context ast.Context
+		switch row := row.(type) {
+		case datasource.Document:
+			context = ast.NewContext(row)
+		default:
+			panic(fmt.Sprintf("Non-map rows not currently supported (saw %T)", row))
+		}
+
+		result, err := this.having.EvaluateBoolean(context)
+		if err != nil {
+			log.Printf("Error evaluating having: %v", err)
+			continue
+		}
+		if result {
+			this.outputChannel <- row
+		}
+	}
+}
+
+func (this *Having) Explain() map[string]interface{} {
+	rv := map[string]interface{}{
+		"type":           "having",
+		"expression":     this.having,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+	if this.Source() != nil {
+		rv["source"] = this.Source().Explain()
+	}
+	return rv
+}
+func (this *Having) Cancel() {}
+
+func (this *Having) Cost() float64 {
+	sourceRows := this.source.EstimatedRows()
+	return float64(sourceRows) * CPU_COST
+}
+
+func (this *Having) EstimatedRows() int {
+	// FIXME this is wrong in most cases
+	// the having is further reducing the number of rows
+	return this.source.EstimatedRows()
+}
+
+func (this *Having) TotalCost() float64 {
+	return this.Cost() + this.source.TotalCost()
+}
+
+func (this *Having) String() string {
+	return OperatorToString(this)
+}
+
+func (this *Having) Source() Operator {
+	return this.source
+}
@@ -22,7 +22,12 @@ func (this *MockOperator) GetOutputChannel() OutputChannel {
 func (this *MockOperator) Run() {
 }
 
-func (this *MockOperator) Explain() {}
+func (this *MockOperator) Explain() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "mock",
+		"cost": this.Cost(),
+	}
+}
 
 func (this *MockOperator) Cancel() {}
 
@@ -30,6 +35,14 @@ func (this *MockOperator) Cost() float64 {
 	return this.MockCost
 }
 
+func (this *MockOperator) EstimatedRows() int {
+	return 0
+}
+
 func (this *MockOperator) TotalCost() float64 {
 	return this.Cost()
 }
+
+func (this *MockOperator) Source() Operator {
+	return nil
+}
@@ -0,0 +1,49 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// rowsOperator is a source producing a fixed list of rows
+type rowsOperator struct {
+	MockOperator
+	rows          []datasource.Document
+	outputChannel OutputChannel
+}
+
+func newRowsOperator(rows []datasource.Document) *rowsOperator {
+	return &rowsOperator{
+		rows:          rows,
+		outputChannel: make(OutputChannel),
+	}
+}
+
+func (this *rowsOperator) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *rowsOperator) Run() {
+	defer close(this.outputChannel)
+	for _, row := range this.rows {
+		this.outputChannel <- row
+	}
+}
+
+// run the operator and collect its output
+func runOperator(operator Operator) []Output {
+	rv := make([]Output, 0)
+	go operator.Run()
+	for row := range operator.GetOutputChannel() {
+		rv = append(rv, row)
+	}
+	return rv
+}
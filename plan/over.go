@@ -0,0 +1,109 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// Over joins each row with every element of the array found at path
+// producing one row per element, with the element stored under alias
+// rows where the path is not an array produce no rows
+type Over struct {
+	source        Operator
+	outputChannel OutputChannel
+	path          *ast.Property
+	alias         string
+}
+
+func NewOver(source Operator, path *ast.Property, alias string) *Over {
+	return &Over{
+		source:        source,
+		outputChannel: make(OutputChannel),
+		path:          path,
+		alias:         alias,
+	}
+}
+
+func (this *Over) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *Over) Run() {
+	defer close(this.outputChannel)
+
+	// start the source
+	go this.source.Run()
+	for row := range this.source.GetOutputChannel() {
+		switch row := row.(type) {
+		case datasource.Document:
+			value, err := this.path.Evaluate(ast.NewContext(row))
+			if err != nil {
+				log.Printf("Error evaluating over path %v: %v", this.path, err)
+				continue
+			}
+			switch value := value.(type) {
+			case []interface{}:
+				for _, element := range value {
+					joined := make(datasource.Document, len(row)+1)
+					for k, v := range row {
+						joined[k] = v
+					}
+					joined[this.alias] = element
+					this.outputChannel <- joined
+				}
+			}
+		default:
+			panic(fmt.Sprintf("Non-map rows not currently supported (saw %T)", row))
+		}
+	}
+}
+
+func (this *Over) Explain() map[string]interface{} {
+	rv := map[string]interface{}{
+		"type":           "over",
+		"path":           this.path.Path,
+		"as":             this.alias,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+	if this.Source() != nil {
+		rv["source"] = this.Source().Explain()
+	}
+	return rv
+}
+func (this *Over) Cancel() {}
+
+func (this *Over) Cost() float64 {
+	sourceRows := this.source.EstimatedRows()
+	return float64(sourceRows) * CPU_COST
+}
+
+func (this *Over) EstimatedRows() int {
+	// FIXME without statistics about the array lengths
+	// assume each row is joined with one element
+	return this.source.EstimatedRows()
+}
+
+func (this *Over) TotalCost() float64 {
+	return this.Cost() + this.source.TotalCost()
+}
+
+func (this *Over) String() string {
+	return OperatorToString(this)
+}
+
+func (this *Over) Source() Operator {
+	return this.source
+}
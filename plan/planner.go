@@ -11,6 +11,8 @@ package plan
 
 import (
 	"log"
+	"reflect"
+	"strings"
 
 	"github.com/couchbaselabs/tuqqedin/ast"
 	"github.com/couchbaselabs/tuqqedin/datasource"
@@ -35,11 +37,22 @@ func (this *CouchbasePlanner) Plan(statement ast.Statement) ([]Operator, error)
 
 	switch statement.GetType() {
 	case ast.STATEMENT_TYPE_SELECT:
-		if len(statement.GetFrom()) != 1 {
-			panic("Only 1 data source is currently supported")
+		if len(statement.GetFrom()) < 1 {
+			panic("A data source is required")
 		}
 
+		// any data sources after the first are joined by OVER
 		namedDataSource := statement.GetFrom()[0]
+		overDataSources := make([]*ast.OverDataSource, 0)
+		for _, dataSource := range statement.GetFrom()[1:] {
+			switch dataSource := dataSource.(type) {
+			case *ast.OverDataSource:
+				overDataSources = append(overDataSources, dataSource)
+			default:
+				panic("Only 1 data source is currently supported")
+			}
+		}
+
 		couchbaseDataSource, err := this.dataSourceManager.GetDataSource(namedDataSource.GetName())
 		if err != nil {
 			return nil, err
@@ -50,43 +63,56 @@ func (this *CouchbasePlanner) Plan(statement ast.Statement) ([]Operator, error)
 		cnf := nnf.ConjunctiveNormalForm()
 		booleanFactors := cnf.ConvertToBooleanFactors()
 
+		// indexes describe whole documents, they cannot be
+		// used when a path within the document is the input
+		path := namedDataSource.GetPath()
+
 		// look at each access path the datasource
 		// and try to create a plan using it
 		for _, accessPath := range couchbaseDataSource.AccessPaths() {
-			if accessPath.ReturnsAll() || accessPath.Matches(booleanFactors) {
+			if accessPath.ReturnsAll() || (path == "" && accessPath.Matches(booleanFactors)) {
 				var currentOperator Operator
 				//sourceOperator, remainingFactors := accessPath.BuildOperator(booleanFactors)
 				// NOTE here we don't currently use the "remaining factors"
 				// because we if we do a fetch, we have to recheck anyway
 				// FIXME if the query is covered by the index it can be avoided
 				currentOperator, _ = buildOperatorForAccessPath(accessPath, booleanFactors)
-				// FIXME need to check select clause to see if we need fetch
-				currentOperator = NewFetch(currentOperator, couchbaseDataSource)
-				currentOperator = NewFilter(currentOperator, booleanFactors)
-
-				order := statement.GetOrder()
-				if len(order) > 0 {
-					currentOperator = NewOrder(currentOperator, order)
+				// the rows of a view hold the index keys, the documents are
+				// only fetched when the query needs more than that
+				if path != "" || len(overDataSources) > 0 || !isCoveredByAccessPath(accessPath, statement) {
+					currentOperator = NewFetch(currentOperator, couchbaseDataSource)
 				}
-
-				offset := statement.GetOffset()
-				if offset > 0 {
-					currentOperator = NewOffset(currentOperator, offset)
+				if path != "" {
+					currentOperator = NewReroot(currentOperator, path)
 				}
+				for _, overDataSource := range overDataSources {
+					currentOperator = NewOver(currentOperator, overDataSource.GetProperty(), overDataSource.GetAlias())
+				}
+				currentOperator = NewFilter(currentOperator, booleanFactors)
 
-				limit := statement.GetLimit()
-				if limit >= 0 {
-					currentOperator = NewLimit(currentOperator, limit)
+				if statement.IsAggregate() {
+					currentOperator = NewGroup(currentOperator, statement.GetGroupBy(), statement.GetAggregateReferences())
 				}
 
-				projection := statement.GetSelect()
-				currentOperator = NewProject(currentOperator, projection)
+				currentOperator = buildOperatorsAfterGroup(statement, currentOperator)
 
 				// add operator as plan
 				rv = append(rv, currentOperator)
 			} else {
 				log.Printf("cannot use ap: %v", accessPath)
 			}
+
+			// aggregate queries may also be answered by the view reduce
+			// (unless OVER changes the rows being aggregated)
+			if statement.IsAggregate() && path == "" && len(overDataSources) == 0 {
+				switch accessPath := accessPath.(type) {
+				case *datasource.CouchbaseViewAccessPath:
+					reducer := buildReducerForAccessPath(accessPath, statement, booleanFactors)
+					if reducer != nil {
+						rv = append(rv, buildOperatorsAfterGroup(statement, reducer))
+					}
+				}
+			}
 		}
 
 	}
@@ -94,6 +120,108 @@ func (this *CouchbasePlanner) Plan(statement ast.Statement) ([]Operator, error)
 	return rv, nil
 }
 
+// the operators following grouping are the same
+// regardless of how the rows were produced
+func buildOperatorsAfterGroup(statement ast.Statement, currentOperator Operator) Operator {
+	having := statement.GetHaving()
+	if having != nil {
+		currentOperator = NewHaving(currentOperator, having)
+	}
+
+	order := statement.GetOrder()
+	if len(order) > 0 {
+		currentOperator = NewOrder(currentOperator, order)
+	}
+
+	projection := statement.GetSelect()
+	if statement.IsDistinct() {
+		currentOperator = NewDistinct(currentOperator, projection, isOrderedByProjection(order, projection))
+	}
+
+	offset := statement.GetOffset()
+	if offset > 0 {
+		currentOperator = NewOffset(currentOperator, offset)
+	}
+
+	limit := statement.GetLimit()
+	if limit >= 0 {
+		currentOperator = NewLimit(currentOperator, limit)
+	}
+
+	return NewProject(currentOperator, projection)
+}
+
+// when the leading ORDER BY expressions are exactly the result
+// expressions, rows with equal projections are adjacent
+func isOrderedByProjection(order []ast.OrderedExpression, projection ast.ResultExpressionList) bool {
+	if len(projection) == 0 || projection.ContainsStar() || len(order) < len(projection) {
+		return false
+	}
+	for _, resultExpr := range projection {
+		found := false
+		for _, oe := range order[:len(projection)] {
+			if reflect.DeepEqual(oe.Expression(), resultExpr.Expr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// a query is covered by a view when every property it references is
+// an index key (or within one) or the meta data of the document
+func isCoveredByAccessPath(accessPath datasource.AccessPath, statement ast.Statement) bool {
+	viewAccessPath, ok := accessPath.(*datasource.CouchbaseViewAccessPath)
+	if !ok {
+		return false
+	}
+
+	projection := statement.GetSelect()
+	if len(projection) == 0 || projection.ContainsStar() {
+		return false
+	}
+
+	properties := projection.ReferencedProperties()
+	properties = append(properties, statement.GetWhere().ReferencedProperties()...)
+	for _, expr := range statement.GetGroupBy() {
+		properties = append(properties, expr.ReferencedProperties()...)
+	}
+	having := statement.GetHaving()
+	if having != nil {
+		properties = append(properties, having.ReferencedProperties()...)
+	}
+	for _, oe := range statement.GetOrder() {
+		properties = append(properties, oe.Expression().ReferencedProperties()...)
+	}
+
+	for _, property := range properties {
+		if !isCoveredProperty(property.Path, viewAccessPath.Keys()) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCoveredProperty(path string, keys []string) bool {
+	if path == "meta" || strings.HasPrefix(path, "meta.") {
+		return true
+	}
+	for _, key := range keys {
+		if strings.Contains(key, "[") {
+			// not part of the rows of the view
+			continue
+		}
+		if path == key || strings.HasPrefix(path, key+".") || strings.HasPrefix(path, key+"[") {
+			return true
+		}
+	}
+	return false
+}
+
 func buildOperatorForAccessPath(accessPath datasource.AccessPath, booleanFactors []ast.BooleanExpression) (Operator, []ast.BooleanExpression) {
 	switch accessPath := accessPath.(type) {
 	case *datasource.CouchbaseAllDocsAccessPath:
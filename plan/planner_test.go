@@ -0,0 +1,118 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/datasource"
+	"github.com/couchbaselabs/tuqqedin/parser"
+	"github.com/couchbaselabs/tuqqedin/stats"
+)
+
+// mockDataSource only has the access paths it is given
+type mockDataSource struct {
+	accessPaths []datasource.AccessPath
+}
+
+func (this *mockDataSource) Name() string { return "beer" }
+
+func (this *mockDataSource) Rows() int { return 0 }
+
+func (this *mockDataSource) PathStats() map[string]stats.PathStatistics {
+	return map[string]stats.PathStatistics{}
+}
+
+func (this *mockDataSource) UpdateStats() {}
+
+func (this *mockDataSource) AccessPaths() []datasource.AccessPath { return this.accessPaths }
+
+func (this *mockDataSource) UpdateAccessPaths() {}
+
+func (this *mockDataSource) Fetch(docID string) (interface{}, error) {
+	return nil, fmt.Errorf("No document %v", docID)
+}
+
+type mockDataSourceManager struct {
+	dataSource datasource.DataSource
+}
+
+func (this *mockDataSourceManager) GetDataSource(name string) (datasource.DataSource, error) {
+	return this.dataSource, nil
+}
+
+// true when the plan fetches the documents
+func hasFetch(operator Operator) bool {
+	for ; operator != nil; operator = operator.Source() {
+		if _, ok := operator.(*Fetch); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// the plan reading the view, rather than answering from its reduce
+func scanPlan(plans []Operator) Operator {
+	for _, plan := range plans {
+		for operator := plan; operator != nil; operator = operator.Source() {
+			if _, ok := operator.(*ViewScanner); ok {
+				return plan
+			}
+		}
+	}
+	return nil
+}
+
+func TestPlanCoveredByView(t *testing.T) {
+
+	tests := []struct {
+		keys  []string
+		query string
+		fetch bool
+	}{
+		{[]string{"doc.type"}, "SELECT doc.type FROM beer WHERE doc.type = \"ale\"", false},
+		{[]string{"doc.type"}, "SELECT doc.type, META().id FROM beer WHERE doc.type > \"ale\" ORDER BY doc.type", false},
+		{[]string{"doc.type", "doc.abv"}, "SELECT doc.abv FROM beer WHERE doc.type = \"ale\" AND doc.abv > 5", false},
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) FROM beer WHERE doc.type > \"ale\" GROUP BY doc.type HAVING COUNT(*) > 5", false},
+		{[]string{"doc.type"}, "SELECT * FROM beer WHERE doc.type = \"ale\"", true},
+		{[]string{"doc.type"}, "SELECT doc.name FROM beer WHERE doc.type = \"ale\"", true},
+		{[]string{"doc.type"}, "SELECT doc.type FROM beer WHERE doc.type = \"ale\" AND doc.abv > 5", true},
+		// ORDER BY and HAVING are also answered from the rows
+		{[]string{"doc.type"}, "SELECT doc.type FROM beer WHERE doc.type = \"ale\" ORDER BY doc.abv", true},
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) FROM beer WHERE doc.type > \"ale\" GROUP BY doc.type HAVING MAX(doc.abv) > 5", true},
+		// the key of an array element is not in the rows of the view
+		{[]string{"doc.tags[0]"}, "SELECT doc.tags[0] FROM beer WHERE doc.tags[0] = \"ale\"", true},
+	}
+
+	unqlParser := parser.NewUnqlParser()
+	for _, test := range tests {
+		statement, err := unqlParser.Parse(test.query)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", test.query, err)
+		}
+
+		accessPath := datasource.NewCouchbaseViewAccessPath(nil, "ddoc", "view", test.keys)
+		planner := NewCouchbasePlanner(&mockDataSourceManager{&mockDataSource{[]datasource.AccessPath{accessPath}}})
+		plans, err := planner.Plan(statement)
+		if err != nil {
+			t.Fatalf("Error planning %v: %v", test.query, err)
+		}
+
+		plan := scanPlan(plans)
+		if plan == nil {
+			t.Fatalf("Expected a plan scanning the view for %v", test.query)
+		}
+		if hasFetch(plan) != test.fetch {
+			t.Errorf("Expected fetch %v for %v with keys %v", test.fetch, test.query, test.keys)
+		}
+	}
+
+}
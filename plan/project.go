@@ -20,10 +20,10 @@ import (
 type Project struct {
 	source        Operator
 	outputChannel OutputChannel
-	projection    ast.Expression
+	projection    ast.ResultExpressionList
 }
 
-func NewProject(source Operator, projection ast.Expression) *Project {
+func NewProject(source Operator, projection ast.ResultExpressionList) *Project {
 	return &Project{
 		source:        source,
 		outputChannel: make(OutputChannel),
@@ -43,7 +43,7 @@ func (this *Project) Run() {
 DOCUMENT:
 	for row := range this.source.GetOutputChannel() {
 
-		if this.projection != nil {
+		if len(this.projection) > 0 {
 			var context ast.Context
 			switch row := row.(type) {
 			case datasource.Document:
@@ -58,8 +58,26 @@ DOCUMENT:
 				log.Printf("Error evaluating projection: %v", err)
 				continue DOCUMENT
 			}
+			// MISSING values are not part of the result object
+			// and a row whose whole result is MISSING has no result
+			if result == ast.MISSING {
+				continue DOCUMENT
+			}
+			switch result := result.(type) {
+			case map[string]interface{}:
+				for k, v := range result {
+					if v == ast.MISSING {
+						delete(result, k)
+					}
+				}
+			}
 			this.outputChannel <- result
 		} else {
+			// the aggregate values are not part of the result
+			switch row := row.(type) {
+			case datasource.Document:
+				delete(row, ast.AGGREGATES_KEY)
+			}
 			this.outputChannel <- row
 		}
 	}
@@ -0,0 +1,66 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+func TestProjectMissing(t *testing.T) {
+
+	rows := []datasource.Document{
+		{"doc": map[string]interface{}{"name": "ale", "abv": nil}},
+		{"doc": map[string]interface{}{}},
+	}
+
+	tests := []struct {
+		projection ast.ResultExpressionList
+		output     []string
+	}{
+		// an unnamed expression which is MISSING is left out like a named one
+		{
+			ast.ResultExpressionList{ast.NewResultExpression(ast.NewProperty("doc.name"))},
+			[]string{`{"":"ale"}`, `{}`},
+		},
+		{
+			ast.ResultExpressionList{ast.NewResultExpressionWithAlias(ast.NewProperty("doc.name"), "name")},
+			[]string{`{"name":"ale"}`, `{}`},
+		},
+		// NULL is still part of the result
+		{
+			ast.ResultExpressionList{
+				ast.NewResultExpressionWithAlias(ast.NewProperty("doc.name"), "name"),
+				ast.NewResultExpressionWithAlias(ast.NewProperty("doc.abv"), "abv"),
+			},
+			[]string{`{"abv":null,"name":"ale"}`, `{}`},
+		},
+	}
+
+	for _, x := range tests {
+		results := runOperator(NewProject(newRowsOperator(rows), x.projection))
+		if len(results) != len(x.output) {
+			t.Fatalf("Expected %d results for %v, got %v", len(x.output), x.projection, results)
+		}
+		for i, result := range results {
+			bytes, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("Error serializing %v: %v", result, err)
+			}
+			if string(bytes) != x.output[i] {
+				t.Errorf("Expected %v for %v, got %v", x.output[i], x.projection, string(bytes))
+			}
+		}
+	}
+
+}
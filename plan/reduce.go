@@ -0,0 +1,327 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"log"
+	"strings"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// ViewReducer computes an aggregate query directly from the _stats
+// reduce of a view, replacing the scan, fetch, filter and group operators
+//
+// the results are grouped by the first groupLevel keys of the index
+// the view itself is queried with a group_level of reduceLevel
+// consecutive rows sharing the same group key are combined into one group
+// rows with a NULL value for a key marked valued are left out
+type ViewReducer struct {
+	accessPath    *datasource.CouchbaseViewAccessPath
+	outputChannel OutputChannel
+	cancelChannel datasource.CancelChannel
+	groupLevel    int
+	reduceLevel   int
+	valuedKeys    []bool
+	aggregates    []ast.AggregateFunction
+}
+
+func NewViewReducer(accessPath *datasource.CouchbaseViewAccessPath, groupLevel int, reduceLevel int, valuedKeys []bool, aggregates []ast.AggregateFunction) *ViewReducer {
+	return &ViewReducer{
+		accessPath:    accessPath,
+		outputChannel: make(OutputChannel),
+		cancelChannel: make(datasource.CancelChannel),
+		groupLevel:    groupLevel,
+		reduceLevel:   reduceLevel,
+		valuedKeys:    valuedKeys,
+		aggregates:    aggregates,
+	}
+}
+
+func (this *ViewReducer) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *ViewReducer) Run() {
+	defer close(this.outputChannel)
+
+	docChannel := make(datasource.DocumentChannel)
+	options := map[string]interface{}{
+		"group_level": this.reduceLevel,
+	}
+	go this.accessPath.Reduce(docChannel, this.cancelChannel, options)
+	this.reduceRows(docChannel)
+}
+
+// combine the reduced rows of the view into groups
+func (this *ViewReducer) reduceRows(docChannel datasource.DocumentChannel) {
+	var groupKey []interface{}
+	var accumulators map[string]ast.Accumulator
+	for doc := range docChannel {
+		keyValues := this.keyValues(doc["key"])
+		count, ok := reducedCount(doc["value"])
+		if !ok {
+			log.Printf("Unexpected reduce value %v, view must use _stats reduce", doc["value"])
+			continue
+		}
+		if !this.isValued(keyValues) {
+			continue
+		}
+
+		// the view returns rows in key order, so a new
+		// group begins whenever the group key changes
+		if accumulators == nil || !sameKey(groupKey, keyValues[:this.groupLevel]) {
+			if accumulators != nil {
+				this.outputChannel <- this.groupRow(groupKey, accumulators)
+			}
+			groupKey = keyValues[:this.groupLevel]
+			accumulators = make(map[string]ast.Accumulator, len(this.aggregates))
+			for _, aggregate := range this.aggregates {
+				accumulators[aggregate.Key()] = aggregate.NewAccumulator()
+			}
+		}
+
+		for _, aggregate := range this.aggregates {
+			var value interface{}
+			operand := aggregate.Operand()
+			if operand != nil {
+				value = keyValues[this.keyIndex(operand.(*ast.Property).Path)]
+			}
+			accumulators[aggregate.Key()].AccumulateValue(value, count)
+		}
+	}
+	if accumulators != nil {
+		this.outputChannel <- this.groupRow(groupKey, accumulators)
+	}
+}
+
+// normalize the key of a reduced row to an array of reduceLevel values
+func (this *ViewReducer) keyValues(key interface{}) []interface{} {
+	return viewKeyValues(this.accessPath.Keys(), key, this.reduceLevel)
+}
+
+// false when a key which must be valued is NULL
+func (this *ViewReducer) isValued(keyValues []interface{}) bool {
+	for i, valued := range this.valuedKeys {
+		if valued && i < len(keyValues) && keyValues[i] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *ViewReducer) keyIndex(path string) int {
+	for i, key := range this.accessPath.Keys() {
+		if key == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// build a row which looks like a row produced by Group
+// the group key values are placed at the path of their index key
+func (this *ViewReducer) groupRow(groupKey []interface{}, accumulators map[string]ast.Accumulator) datasource.Document {
+	rv := datasource.Document{}
+	for i, value := range groupKey {
+		setPath(rv, this.accessPath.Keys()[i], value)
+	}
+	aggregateValues := make(map[string]interface{}, len(accumulators))
+	for key, accumulator := range accumulators {
+		aggregateValues[key] = accumulator.Value()
+	}
+	rv[ast.AGGREGATES_KEY] = aggregateValues
+	return rv
+}
+
+func (this *ViewReducer) Explain() map[string]interface{} {
+	aggregates := make([]string, 0, len(this.aggregates))
+	for _, aggregate := range this.aggregates {
+		aggregates = append(aggregates, aggregate.Key())
+	}
+	return map[string]interface{}{
+		"type":           "reduce",
+		"index":          this.accessPath.Name(),
+		"group_level":    this.reduceLevel,
+		"aggregates":     aggregates,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+}
+
+func (this *ViewReducer) Cancel() {
+	close(this.cancelChannel)
+}
+
+func (this *ViewReducer) Cost() float64 {
+	return (float64(this.EstimatedRows()) / datasource.BATCH_SIZE) * NETWORK_COST
+}
+
+func (this *ViewReducer) EstimatedRows() int {
+	// if we have no stats at all
+	rv := this.accessPath.DataSource().Rows()
+
+	// FIXME only works with 1 level index
+	pathStats := this.accessPath.DataSource().PathStats()
+	pathStat, ok := pathStats[this.accessPath.Keys()[0]]
+	if ok && this.reduceLevel == 1 {
+		// one row per distinct value of the first key
+		rv = pathStat.DistinctValues
+	}
+
+	return rv
+}
+
+func (this *ViewReducer) TotalCost() float64 { return this.Cost() }
+
+func (this *ViewReducer) String() string {
+	return OperatorToString(this)
+}
+
+func (this *ViewReducer) Source() Operator {
+	return nil
+}
+
+// a view can be used to compute an aggregate query if the GROUP BY
+// clause is exactly the index keys and every aggregate is over an
+// index key (or is COUNT(*))
+//
+// a document is only in the view when it has every index key, while
+// Group also puts the documents without a key in a group of their own,
+// so the WHERE clause must require every key to be there, with IS NOT
+// MISSING or IS VALUED (which also leaves out the NULL rows of the view)
+// and nothing else
+func buildReducerForAccessPath(accessPath *datasource.CouchbaseViewAccessPath, statement ast.Statement, booleanFactors []ast.BooleanExpression) *ViewReducer {
+	keys := accessPath.Keys()
+	requiredKeys := make([]bool, len(keys))
+	valuedKeys := make([]bool, len(keys))
+	for _, booleanFactor := range booleanFactors {
+		switch booleanFactor := booleanFactor.(type) {
+		case *ast.LiteralBool:
+			if !booleanFactor.Value {
+				return nil
+			}
+		case *ast.IsNotMissingOperator, *ast.IsNotNullOperator:
+			// FIXME the reduce could be restricted to the ranges
+			// supported by the view, for now only these are allowed
+			keyIndex := -1
+			property := booleanFactor.GetSargProperty()
+			for i, key := range keys {
+				if property != nil && property.Path == key {
+					keyIndex = i
+				}
+			}
+			if keyIndex == -1 {
+				return nil
+			}
+			requiredKeys[keyIndex] = true
+			if _, ok := booleanFactor.(*ast.IsNotNullOperator); ok {
+				valuedKeys[keyIndex] = true
+			}
+		default:
+			return nil
+		}
+	}
+	for _, required := range requiredKeys {
+		if !required {
+			return nil
+		}
+	}
+
+	groupBy := statement.GetGroupBy()
+	if len(groupBy) != len(keys) {
+		return nil
+	}
+	for i, expr := range groupBy {
+		property, ok := expr.(*ast.Property)
+		if !ok || property.Path != keys[i] || strings.Contains(property.Path, "[") {
+			return nil
+		}
+	}
+
+	aggregates := statement.GetAggregateReferences()
+	for _, aggregate := range aggregates {
+		operand := aggregate.Operand()
+		if operand == nil {
+			// only COUNT(*) has no operand
+			continue
+		}
+		property, ok := operand.(*ast.Property)
+		if !ok {
+			return nil
+		}
+		found := false
+		for _, key := range keys {
+			if key == property.Path {
+				found = true
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	return NewViewReducer(accessPath, len(groupBy), len(keys), valuedKeys, aggregates)
+}
+
+func reducedCount(value interface{}) (int, bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch count := value["count"].(type) {
+		case float64:
+			return int(count), true
+		}
+	}
+	return 0, false
+}
+
+func sameKey(left, right []interface{}) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if ast.CollateJSON(left[i], right[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize the key of a view row to an array of the values of the
+// first n index keys
+func viewKeyValues(keys []string, key interface{}, n int) []interface{} {
+	rv := make([]interface{}, n)
+	if len(keys) == 1 {
+		// single key indexes do not emit array keys
+		rv[0] = key
+		return rv
+	}
+	switch key := key.(type) {
+	case []interface{}:
+		copy(rv, key)
+	}
+	return rv
+}
+
+// set the value at the dotted path, creating objects along the way
+func setPath(doc map[string]interface{}, path string, value interface{}) {
+	elements := strings.Split(path, ".")
+	curr := doc
+	for _, element := range elements[:len(elements)-1] {
+		next, ok := curr[element].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			curr[element] = next
+		}
+		curr = next
+	}
+	curr[elements[len(elements)-1]] = value
+}
@@ -0,0 +1,204 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+	"github.com/couchbaselabs/tuqqedin/parser"
+)
+
+// reducedRowsOperator runs a ViewReducer over fixed reduced view rows
+type reducedRowsOperator struct {
+	*ViewReducer
+	rows []datasource.Document
+}
+
+func (this *reducedRowsOperator) Run() {
+	defer close(this.outputChannel)
+
+	docChannel := make(datasource.DocumentChannel)
+	go func() {
+		defer close(docChannel)
+		for _, row := range this.rows {
+			docChannel <- row
+		}
+	}()
+	this.reduceRows(docChannel)
+}
+
+// build the rows a view with a _stats reduce would return for
+// the documents when queried with a group_level of every key
+func reducedViewRows(keys []string, docs []datasource.Document) []datasource.Document {
+	rows := make([]datasource.Document, 0)
+	for _, doc := range docs {
+		keyValues := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			value, err := ast.NewProperty(key).Evaluate(ast.NewContext(doc))
+			if err != nil || value == ast.MISSING {
+				break
+			}
+			keyValues = append(keyValues, value)
+		}
+		if len(keyValues) != len(keys) {
+			// documents without every key are not emitted
+			continue
+		}
+
+		var key interface{} = keyValues
+		if len(keys) == 1 {
+			key = keyValues[0]
+		}
+
+		found := false
+		for _, row := range rows {
+			if ast.CollateJSON(row["key"], key) == 0 {
+				stats := row["value"].(map[string]interface{})
+				stats["count"] = stats["count"].(float64) + 1
+				found = true
+				break
+			}
+		}
+		if !found {
+			rows = append(rows, datasource.Document{
+				"key":   key,
+				"value": map[string]interface{}{"count": 1.0},
+			})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return ast.CollateJSON(rows[i]["key"], rows[j]["key"]) < 0
+	})
+	return rows
+}
+
+func sortOutputs(outputs []Output) {
+	sort.Slice(outputs, func(i, j int) bool {
+		return ast.CollateJSON(outputs[i], outputs[j]) < 0
+	})
+}
+
+func TestViewReducerMatchesGroup(t *testing.T) {
+
+	docs := []datasource.Document{
+		{"doc": map[string]interface{}{"type": "stout", "abv": 8.0}},
+		{"doc": map[string]interface{}{"type": "ale", "abv": 5.0}},
+		{"doc": map[string]interface{}{"type": "ale", "abv": 6.0}},
+		{"doc": map[string]interface{}{"type": "stout", "abv": 8.0}},
+		{"doc": map[string]interface{}{"type": "ale", "abv": 5.0}},
+		{"doc": map[string]interface{}{"type": nil, "abv": 4.0}},
+		{"doc": map[string]interface{}{"type": "lager", "abv": 4.5}},
+		// not in the view
+		{"doc": map[string]interface{}{"abv": 5.0}},
+		{"doc": map[string]interface{}{"type": "ale"}},
+	}
+
+	tests := []struct {
+		keys  []string
+		query string
+	}{
+		{
+			[]string{"doc.type"},
+			"SELECT doc.type AS type, COUNT(*) AS count WHERE doc.type IS NOT MISSING GROUP BY doc.type",
+		},
+		{
+			[]string{"doc.type"},
+			"SELECT doc.type AS type, COUNT(*) AS count WHERE doc.type IS VALUED GROUP BY doc.type",
+		},
+		{
+			[]string{"doc.type"},
+			"SELECT doc.type AS type, COUNT(doc.type) AS count, MIN(doc.type) AS min WHERE doc.type IS NOT MISSING GROUP BY doc.type",
+		},
+		{
+			[]string{"doc.type", "doc.abv"},
+			"SELECT doc.type AS type, doc.abv AS abv, COUNT(*) AS count, SUM(doc.abv) AS total, AVG(doc.abv) AS avg WHERE doc.type IS NOT MISSING AND doc.abv IS NOT MISSING GROUP BY doc.type, doc.abv",
+		},
+		{
+			[]string{"doc.type", "doc.abv"},
+			"SELECT doc.type AS type, doc.abv AS abv, COUNT(*) AS count WHERE doc.abv IS NOT MISSING AND doc.type IS VALUED GROUP BY doc.type, doc.abv",
+		},
+	}
+
+	unqlParser := parser.NewUnqlParser()
+	for _, test := range tests {
+		statement, err := unqlParser.Parse(test.query)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", test.query, err)
+		}
+		booleanFactors := statement.GetWhere().NegationNormalForm().ConjunctiveNormalForm().ConvertToBooleanFactors()
+
+		accessPath := datasource.NewCouchbaseViewAccessPath(nil, "ddoc", "view", test.keys)
+		reducer := buildReducerForAccessPath(accessPath, statement, booleanFactors)
+		if reducer == nil {
+			t.Fatalf("Expected a reducer for %v", test.query)
+		}
+
+		reduced := &reducedRowsOperator{reducer, reducedViewRows(test.keys, docs)}
+		reducerResults := runOperator(NewProject(reduced, statement.GetSelect()))
+
+		filter := NewFilter(newRowsOperator(docs), booleanFactors)
+		group := NewGroup(filter, statement.GetGroupBy(), statement.GetAggregateReferences())
+		groupResults := runOperator(NewProject(group, statement.GetSelect()))
+
+		if len(groupResults) == 0 {
+			t.Fatalf("Expected groups for %v", test.query)
+		}
+		sortOutputs(reducerResults)
+		sortOutputs(groupResults)
+		if !reflect.DeepEqual(reducerResults, groupResults) {
+			t.Errorf("Expected %v to reduce to %v, got %v", test.query, groupResults, reducerResults)
+		}
+	}
+
+}
+
+func TestBuildReducerForAccessPath(t *testing.T) {
+
+	tests := []struct {
+		keys    []string
+		query   string
+		reduces bool
+	}{
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) WHERE doc.type IS NOT MISSING GROUP BY doc.type", true},
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) WHERE doc.type IS VALUED GROUP BY doc.type", true},
+		{[]string{"doc.type", "doc.abv"}, "SELECT doc.type, doc.abv, COUNT(*) WHERE doc.type IS NOT MISSING AND doc.abv IS NOT MISSING GROUP BY doc.type, doc.abv", true},
+		// documents without the keys would have a group of their own
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) GROUP BY doc.type", false},
+		{[]string{"doc.type", "doc.abv"}, "SELECT doc.type, doc.abv, COUNT(*) WHERE doc.type IS NOT MISSING GROUP BY doc.type, doc.abv", false},
+		// documents without doc.abv would be missing from the count
+		{[]string{"doc.type", "doc.abv"}, "SELECT doc.type, COUNT(*) WHERE doc.type IS NOT MISSING GROUP BY doc.type", false},
+		{[]string{"doc.type", "doc.abv"}, "SELECT doc.type, MAX(doc.abv) WHERE doc.type IS NOT MISSING GROUP BY doc.type", false},
+		{[]string{"doc.type"}, "SELECT doc.type, doc.abv, COUNT(*) WHERE doc.type IS NOT MISSING GROUP BY doc.type, doc.abv", false},
+		{[]string{"doc.type"}, "SELECT doc.abv, COUNT(*) WHERE doc.type IS NOT MISSING GROUP BY doc.abv", false},
+		{[]string{"doc.type"}, "SELECT doc.type, SUM(doc.abv) WHERE doc.type IS NOT MISSING GROUP BY doc.type", false},
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) WHERE doc.type IS NOT MISSING AND doc.abv > 5 GROUP BY doc.type", false},
+		{[]string{"doc.type"}, "SELECT doc.type, COUNT(*) WHERE doc.abv IS NOT MISSING GROUP BY doc.type", false},
+	}
+
+	unqlParser := parser.NewUnqlParser()
+	for _, test := range tests {
+		statement, err := unqlParser.Parse(test.query)
+		if err != nil {
+			t.Fatalf("Error parsing %v: %v", test.query, err)
+		}
+		booleanFactors := statement.GetWhere().NegationNormalForm().ConjunctiveNormalForm().ConvertToBooleanFactors()
+
+		accessPath := datasource.NewCouchbaseViewAccessPath(nil, "ddoc", "view", test.keys)
+		reducer := buildReducerForAccessPath(accessPath, statement, booleanFactors)
+		if (reducer != nil) != test.reduces {
+			t.Errorf("Expected reduce %v for %v with keys %v", test.reduces, test.query, test.keys)
+		}
+	}
+
+}
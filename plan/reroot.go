@@ -0,0 +1,101 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+// Reroot replaces the document in each row with the value
+// found at a path within it (FROM bucket.path)
+// documents where the value is NULL or MISSING produce no row
+type Reroot struct {
+	source        Operator
+	outputChannel OutputChannel
+	path          string
+	property      *ast.Property
+}
+
+func NewReroot(source Operator, path string) *Reroot {
+	return &Reroot{
+		source:        source,
+		outputChannel: make(OutputChannel),
+		path:          path,
+		property:      ast.NewProperty(ast.DOCUMENT_KEY + "." + path),
+	}
+}
+
+func (this *Reroot) GetOutputChannel() OutputChannel {
+	return this.outputChannel
+}
+
+func (this *Reroot) Run() {
+	defer close(this.outputChannel)
+
+	// start the source
+	go this.source.Run()
+	for row := range this.source.GetOutputChannel() {
+		switch row := row.(type) {
+		case datasource.Document:
+			value, err := this.property.Evaluate(ast.NewContext(row))
+			if err != nil {
+				log.Printf("Error evaluating path %v: %v", this.path, err)
+				continue
+			}
+			if value == nil || value == ast.MISSING {
+				continue
+			}
+			row[ast.DOCUMENT_KEY] = value
+			this.outputChannel <- row
+		default:
+			panic(fmt.Sprintf("Non-map rows not currently supported (saw %T)", row))
+		}
+	}
+}
+
+func (this *Reroot) Explain() map[string]interface{} {
+	rv := map[string]interface{}{
+		"type":           "reroot",
+		"path":           this.path,
+		"estimated_rows": this.EstimatedRows(),
+		"cost":           this.Cost(),
+	}
+	if this.Source() != nil {
+		rv["source"] = this.Source().Explain()
+	}
+	return rv
+}
+func (this *Reroot) Cancel() {}
+
+func (this *Reroot) Cost() float64 {
+	sourceRows := this.source.EstimatedRows()
+	return float64(sourceRows) * CPU_COST
+}
+
+func (this *Reroot) EstimatedRows() int {
+	// FIXME documents without the path are dropped
+	return this.source.EstimatedRows()
+}
+
+func (this *Reroot) TotalCost() float64 {
+	return this.Cost() + this.source.TotalCost()
+}
+
+func (this *Reroot) String() string {
+	return OperatorToString(this)
+}
+
+func (this *Reroot) Source() Operator {
+	return this.source
+}
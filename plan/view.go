@@ -12,6 +12,7 @@ package plan
 import (
 	//	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/couchbaselabs/go-couchbase"
@@ -20,7 +21,11 @@ import (
 )
 
 var MIN_KEY interface{}
-var MAX_KEY = map[string]interface{}{} //FIXME better approximation of max
+
+// objects sort after every other value and an object sorts after
+// {} as soon as it has a key, so the largest key is an object whose
+// key collates after the keys of documents, like the end of a LIKE range
+var MAX_KEY = map[string]interface{}{"\uefff": map[string]interface{}{}}
 
 // a key in Couchbase is at most 250 bytes
 // in a view, items with the same key are sorted by id (using basic memcmp comparison)
@@ -62,7 +67,7 @@ func NewViewLocationGreatherThan(offset interface{}, inclusive bool) *ViewLocati
 
 func (this *ViewLocation) Compare(that *ViewLocation) int {
 	result := ast.CollateJSON(this.Key, that.Key)
-	if result != 0 {
+	if result == 0 {
 		// if keys are the same, compare docis
 		if *this.Docid < *that.Docid {
 			result = -1
@@ -76,6 +81,26 @@ func (this *ViewLocation) Compare(that *ViewLocation) int {
 	return result
 }
 
+// the location within a view emitting array keys of the location of
+// the next key following the values of prefix, when more keys follow
+// a location after a value also has to be after every key beginning with it
+func NewViewLocationWithPrefix(prefix []interface{}, location *ViewLocation, moreKeys bool) *ViewLocation {
+	key := appendKey(prefix, location.Key)
+	if moreKeys && location.Docid == &MAX_ID {
+		key = append(key, MAX_KEY)
+	}
+	return &ViewLocation{
+		Key:   key,
+		Docid: location.Docid,
+	}
+}
+
+func appendKey(prefix []interface{}, key interface{}) []interface{} {
+	rv := make([]interface{}, len(prefix), len(prefix)+2)
+	copy(rv, prefix)
+	return append(rv, key)
+}
+
 type ViewRange struct {
 	Start *ViewLocation
 	End   *ViewLocation
@@ -90,6 +115,36 @@ func (this *ViewRange) IsSubsetOf(that *ViewRange) bool {
 	return that.Contains(this.Start) && that.Contains(this.End)
 }
 
+// true when no row can be in the range, either it ends before it starts
+// or it is the single location of MIN_ID or MAX_ID which no document has
+func (this *ViewRange) IsEmpty() bool {
+	result := this.Start.Compare(this.End)
+	if result == 0 {
+		return *this.Start.Docid == MIN_ID || *this.Start.Docid == MAX_ID
+	}
+	return result > 0
+}
+
+// the locations in both ranges, nil when there are none
+func (this *ViewRange) Intersect(that *ViewRange) *ViewRange {
+	rv := &ViewRange{this.Start, this.End}
+	if that.Start.Compare(rv.Start) > 0 {
+		rv.Start = that.Start
+	}
+	if that.End.Compare(rv.End) < 0 {
+		rv.End = that.End
+	}
+	if rv.IsEmpty() {
+		return nil
+	}
+	return rv
+}
+
+// true when the range holds every row of one key and nothing else
+func (this *ViewRange) IsSingleKey() bool {
+	return this.Start.Docid == &MIN_ID && this.End.Docid == &MAX_ID && ast.CollateJSON(this.Start.Key, this.End.Key) == 0
+}
+
 func (this *ViewRange) AsViewQueryOptions() map[string]interface{} {
 	return map[string]interface{}{
 		"startkey":       this.Start.Key,
@@ -123,21 +178,29 @@ func (this *ViewRange) Printable() map[string]interface{} {
 	return rv
 }
 
+// the ranges and supported factors are kept for each key of the index
+// an index with more than one key emits array keys, the scan uses the
+// leading keys restricted to single values and the range of the key after them
 type ViewScanner struct {
 	accessPath       *datasource.CouchbaseViewAccessPath
 	outputChannel    OutputChannel
 	cancelChannel    datasource.CancelChannel
-	supportedFactors []ast.BooleanExpression
-	ranges           []*ViewRange
+	supportedFactors [][]ast.BooleanExpression
+	ranges           [][]*ViewRange
 }
 
 func NewViewScanner(accessPath *datasource.CouchbaseViewAccessPath) *ViewScanner {
+	keys := accessPath.Keys()
 	rv := &ViewScanner{
 		accessPath:       accessPath,
 		outputChannel:    make(OutputChannel),
 		cancelChannel:    make(datasource.CancelChannel),
-		supportedFactors: make([]ast.BooleanExpression, 0, 0),
-		ranges:           []*ViewRange{&ViewRange{MIN_LOCATION, MAX_LOCATION}},
+		supportedFactors: make([][]ast.BooleanExpression, len(keys)),
+		ranges:           make([][]*ViewRange, len(keys)),
+	}
+	for i := range keys {
+		rv.supportedFactors[i] = make([]ast.BooleanExpression, 0, 0)
+		rv.ranges[i] = []*ViewRange{&ViewRange{MIN_LOCATION, MAX_LOCATION}}
 	}
 	return rv
 }
@@ -148,23 +211,47 @@ func (this *ViewScanner) AddBooleanFactor(factor ast.BooleanExpression) bool {
 		return false
 	}
 
-	// now make sure the sarg property matches the index
+	// now make sure the sarg property matches a key of the index
 	sargProperty := factor.GetSargProperty()
-	if sargProperty.Path != this.accessPath.Keys()[0] {
+	keyIndex := -1
+	for i, key := range this.accessPath.Keys() {
+		if sargProperty.Path == key {
+			keyIndex = i
+		}
+	}
+	if keyIndex == -1 {
 		return false
 	}
 
-	// calcuate the sarg value
-	sargval, err := factor.GetSargValue()
+	// a new array of view ranges to support this boolean factor
+	newRanges, err := factorRanges(factor)
 	if err != nil {
 		log.Printf("Error determining sarg value: %v", err)
 		return false
 	}
 
+	// now that we've computed the new ranges, we must merge them with our existing ranges
+	this.MergeRange(keyIndex, newRanges)
+
+	// now that we've updated our rangs, add the factor to the supported factor list
+	this.supportedFactors[keyIndex] = append(this.supportedFactors[keyIndex], factor)
+
+	return true
+}
+
+// the ranges of the index key holding the rows which can satisfy the
+// sargable factor, no ranges when no row can satisfy it
+func factorRanges(factor ast.BooleanExpression) ([]*ViewRange, error) {
+	// calcuate the sarg value
+	sargval, err := factor.GetSargValue()
+	if err != nil {
+		return nil, err
+	}
+
 	// a new array of view ranges to support this boolean factor
 	newRanges := make([]*ViewRange, 0, 0)
 
-	switch factor.(type) {
+	switch factor := factor.(type) {
 	case *ast.NotEqualToOperator:
 		leftRange := &ViewRange{MIN_LOCATION, NewViewLocationLessThan(sargval, false)}
 		newRanges = append(newRanges, leftRange)
@@ -185,54 +272,190 @@ func (this *ViewScanner) AddBooleanFactor(factor ast.BooleanExpression) bool {
 	case *ast.EqualToOperator:
 		r := &ViewRange{NewViewLocationGreatherThan(sargval, true), NewViewLocationLessThan(sargval, true)}
 		newRanges = append(newRanges, r)
+	case *ast.BetweenOperator:
+		bounds := sargval.([]interface{})
+		r := &ViewRange{NewViewLocationGreatherThan(bounds[0], true), NewViewLocationLessThan(bounds[1], true)}
+		newRanges = append(newRanges, r)
+	case *ast.LikeOperator:
+		// only the literal prefix can be scanned, the rest
+		// of the pattern is checked by the filter
+		r := &ViewRange{NewViewLocationGreatherThan(sargval, true), NewViewLocationLessThan(ast.LikePrefixUpperBound(sargval.(string)), true)}
+		newRanges = append(newRanges, r)
+	case *ast.IsNullOperator:
+		r := &ViewRange{NewViewLocationGreatherThan(nil, true), NewViewLocationLessThan(nil, true)}
+		newRanges = append(newRanges, r)
+	case *ast.IsNotNullOperator:
+		r := &ViewRange{NewViewLocationGreatherThan(nil, false), MAX_LOCATION}
+		newRanges = append(newRanges, r)
+	case *ast.IsNotMissingOperator:
+		// every document in the index has the key
+		r := &ViewRange{MIN_LOCATION, MAX_LOCATION}
+		newRanges = append(newRanges, r)
+	case *ast.InOperator:
+		for _, value := range sargval.([]interface{}) {
+			r := &ViewRange{NewViewLocationGreatherThan(value, true), NewViewLocationLessThan(value, true)}
+			newRanges = append(newRanges, r)
+		}
+		newRanges = UnionRanges(newRanges)
+	case *ast.OrOperator:
+		for _, operand := range factor.Operands() {
+			operandRanges, err := factorRanges(operand)
+			if err != nil {
+				return nil, err
+			}
+			newRanges = append(newRanges, operandRanges...)
+		}
+		newRanges = UnionRanges(newRanges)
+	default:
+		// the factor does not narrow the scan
+		r := &ViewRange{MIN_LOCATION, MAX_LOCATION}
+		newRanges = append(newRanges, r)
 	}
 
-	// now that we've computed the new ranges, we must merge them with our existing ranges
-	for _, r := range newRanges {
-		this.MergeRange(r)
-	}
-
-	// now that we've updated our rangs, add the factor to the supported factor list
-	this.supportedFactors = append(this.supportedFactors, factor)
-
-	return true
+	return newRanges, nil
 }
 
-// the idea here is to combine ranges where we can
+// the ranges of a key are kept sorted and without overlaps
 // the start state for this is a single range from MIN_LOCATION - MAX_LOCATION
 // if you add x < 7
 // you should get MIN_LOCATION - 7
 // if you then added x > 3
 // you should get 3 - 7
-// sometimes there are ranges which cannot be merged
-// consider x < 3 and x > 7
+// the ranges of a factor like x < 3 OR x > 7 cannot be combined
 // in this case we should get 2 ranges
 // MIN_LOCATION - 3 and 7 - MAX_LOCATION
-// this should also eliminiate some redundant expressions
-// consider x < 3 and x < 7
-func (this *ViewScanner) MergeRange(newRange *ViewRange) {
-	log.Printf("considering new range %v", newRange)
-	for _, r := range this.ranges {
-		// if the new range is a subset of an existing range
-		// this new ragne replaces it
-		if newRange.IsSubsetOf(r) {
-			log.Printf("new is subset, shrinking")
-			r.Start = newRange.Start
-			r.End = newRange.End
-			return
+// if you then added x > 5 only 7 - MAX_LOCATION is left
+// and adding x < 6 as well leaves no ranges, no row can satisfy them all
+func (this *ViewScanner) MergeRange(keyIndex int, newRanges []*ViewRange) {
+	this.ranges[keyIndex] = IntersectRanges(this.ranges[keyIndex], newRanges)
+	log.Printf("merged ranges of key %d: %v", keyIndex, this.ranges[keyIndex])
+}
+
+// the sorted ranges of the locations in one of the left ranges
+// and in one of the right ranges
+func IntersectRanges(left, right []*ViewRange) []*ViewRange {
+	rv := make([]*ViewRange, 0, len(left)+len(right))
+	for _, l := range left {
+		for _, r := range right {
+			intersection := l.Intersect(r)
+			if intersection != nil {
+				rv = append(rv, intersection)
+			}
+		}
+	}
+	return UnionRanges(rv)
+}
+
+// the sorted ranges covering every location of the ranges
+// overlapping ranges are combined into one and empty ranges dropped
+func UnionRanges(ranges []*ViewRange) []*ViewRange {
+	sorted := make(viewRangesByStart, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Sort(sorted)
+
+	rv := make([]*ViewRange, 0, len(sorted))
+	for _, r := range sorted {
+		if len(rv) > 0 {
+			last := rv[len(rv)-1]
+			if r.Start.Compare(last.End) <= 0 {
+				if r.End.Compare(last.End) > 0 {
+					rv[len(rv)-1] = &ViewRange{last.Start, r.End}
+				}
+				continue
+			}
+		}
+		rv = append(rv, r)
+	}
+	return rv
+}
+
+type viewRangesByStart []*ViewRange
+
+func (this viewRangesByStart) Len() int {
+	return len(this)
+}
+
+func (this viewRangesByStart) Less(i, j int) bool {
+	return this[i].Start.Compare(this[j].Start) < 0
+}
+
+func (this viewRangesByStart) Swap(i, j int) {
+	this[i], this[j] = this[j], this[i]
+}
+
+// true when no row can satisfy the factors on some key
+func (this *ViewScanner) IsEmpty() bool {
+	for _, ranges := range this.ranges {
+		if len(ranges) == 0 {
+			return true
 		}
+	}
+	return false
+}
 
-		if r.IsSubsetOf(newRange) {
-			log.Printf("old subset, dropping")
-			// drop this factor
-			// we already have a stricter range that contains it
-			return
+// the number of leading index keys used by the scan, every key before
+// the last one used is restricted to single values
+func (this *ViewScanner) usedKeys() int {
+	for i, ranges := range this.ranges {
+		for _, r := range ranges {
+			if !r.IsSingleKey() {
+				return i + 1
+			}
 		}
 	}
+	return len(this.ranges)
+}
 
-	log.Printf("couldn't merge, so add")
-	// if we got here, we add it as a new range
-	this.ranges = append(this.ranges, newRange)
+// the factors of the keys used by the scan
+func (this *ViewScanner) usedFactors() []ast.BooleanExpression {
+	rv := make([]ast.BooleanExpression, 0)
+	for _, factors := range this.supportedFactors[:this.usedKeys()] {
+		rv = append(rv, factors...)
+	}
+	return rv
+}
+
+// the ranges of the view to scan, for an index with more than one key
+// each combination of the values of the leading keys is the prefix
+// of the array keys of the ranges of the last key used
+func (this *ViewScanner) Ranges() []*ViewRange {
+	if this.IsEmpty() {
+		// nothing to scan
+		return []*ViewRange{}
+	}
+
+	if len(this.ranges) == 1 {
+		// single key indexes do not emit array keys
+		return this.ranges[0]
+	}
+
+	usedKeys := this.usedKeys()
+	prefixes := [][]interface{}{[]interface{}{}}
+	for _, ranges := range this.ranges[:usedKeys-1] {
+		newPrefixes := make([][]interface{}, 0, len(prefixes)*len(ranges))
+		for _, prefix := range prefixes {
+			for _, r := range ranges {
+				newPrefixes = append(newPrefixes, appendKey(prefix, r.Start.Key))
+			}
+		}
+		prefixes = newPrefixes
+	}
+
+	moreKeys := usedKeys < len(this.ranges)
+	rv := make([]*ViewRange, 0, len(prefixes)*len(this.ranges[usedKeys-1]))
+	for _, prefix := range prefixes {
+		for _, r := range this.ranges[usedKeys-1] {
+			rv = append(rv, &ViewRange{
+				Start: NewViewLocationWithPrefix(prefix, r.Start, moreKeys),
+				End:   NewViewLocationWithPrefix(prefix, r.End, moreKeys),
+			})
+		}
+	}
+	return rv
 }
 
 func (this *ViewScanner) GetOutputChannel() OutputChannel {
@@ -242,19 +465,35 @@ func (this *ViewScanner) GetOutputChannel() OutputChannel {
 func (this *ViewScanner) Run() {
 	defer close(this.outputChannel)
 
-	for _, r := range this.ranges {
+	for _, r := range this.Ranges() {
 		docChannel := make(datasource.DocumentChannel)
 		options := r.AsViewQueryOptions()
 		options["reduce"] = false
 
 		go this.accessPath.Scan(docChannel, this.cancelChannel, options)
 		for doc := range docChannel {
-			this.outputChannel <- doc
+			this.outputChannel <- this.keyRow(doc)
 		}
 	}
 
 }
 
+// replace the key of a view row with a document holding the values
+// of the index keys at their paths, a later fetch replaces the document
+// keys with array indexes cannot be placed in the document and are left out
+func (this *ViewScanner) keyRow(doc datasource.Document) datasource.Document {
+	key := doc["key"]
+	delete(doc, "key")
+
+	keys := this.accessPath.Keys()
+	for i, value := range viewKeyValues(keys, key, len(keys)) {
+		if !strings.Contains(keys[i], "[") {
+			setPath(doc, keys[i], value)
+		}
+	}
+	return doc
+}
+
 func (this *ViewScanner) Explain() map[string]interface{} {
 	rv := map[string]interface{}{
 		"type":           "scan",
@@ -264,8 +503,9 @@ func (this *ViewScanner) Explain() map[string]interface{} {
 	}
 
 	// ranges can contain some invalid JSON, so rewrite it clean
-	printableRanges := make([]map[string]interface{}, 0, len(this.ranges))
-	for _, r := range this.ranges {
+	ranges := this.Ranges()
+	printableRanges := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
 		printableRanges = append(printableRanges, r.Printable())
 	}
 	rv["ranges"] = printableRanges
@@ -281,10 +521,16 @@ func (this *ViewScanner) Cost() float64 {
 }
 
 func (this *ViewScanner) EstimatedRows() int {
+	if this.IsEmpty() {
+		return 0
+	}
+
 	// if we have no stats at all
 	rv := this.accessPath.DataSource().Rows()
 
-	// FIXME only works with 1 level index
+	// FIXME only the stats of the first key give the rows of the index,
+	// an index with more keys only has the documents with every key
+	// so this over estimates, the factors of every key used are folded in
 	pathStats := this.accessPath.DataSource().PathStats()
 	indexKey := this.accessPath.Keys()[0]
 	pathStat, ok := pathStats[indexKey]
@@ -292,9 +538,10 @@ func (this *ViewScanner) EstimatedRows() int {
 		// we have path stats for this index
 		rv = pathStat.Rows
 
-		// now compute the selectivity factor of all the boolean factors we're supporting
+		// now compute the selectivity factor of the boolean factors
+		// we're supporting on every key used by the scan
 		sf := 1.0
-		for _, factor := range this.supportedFactors {
+		for _, factor := range this.usedFactors() {
 			sf = sf * factor.GetSelectivity(pathStats)
 		}
 
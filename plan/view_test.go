@@ -0,0 +1,476 @@
+//  Copyright (c) 2013 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/couchbaselabs/go-couchbase"
+	"github.com/couchbaselabs/tuqqedin/ast"
+	"github.com/couchbaselabs/tuqqedin/datasource"
+)
+
+func TestViewLocationCompare(t *testing.T) {
+
+	tests := []struct {
+		left   *ViewLocation
+		right  *ViewLocation
+		output int
+	}{
+		{NewViewLocationGreatherThan(5.0, true), NewViewLocationGreatherThan(5.0, true), 0},
+		{NewViewLocationGreatherThan(5.0, true), NewViewLocationLessThan(5.0, true), -1},
+		{NewViewLocationLessThan(5.0, false), NewViewLocationGreatherThan(5.0, true), 0},
+		{NewViewLocationLessThan(5.0, true), NewViewLocationGreatherThan(5.0, false), 0},
+		{NewViewLocationGreatherThan(5.0, false), NewViewLocationLessThan(5.0, false), 1},
+		// the docid only matters when the keys are the same
+		{NewViewLocationLessThan(3.0, true), NewViewLocationGreatherThan(5.0, true), -1},
+		{NewViewLocationGreatherThan(7.0, true), NewViewLocationLessThan(5.0, true), 1},
+		{MIN_LOCATION, NewViewLocationGreatherThan(nil, true), 0},
+		{MIN_LOCATION, NewViewLocationLessThan(nil, true), -1},
+		{MAX_LOCATION, NewViewLocationLessThan("z", true), 1},
+	}
+
+	for _, x := range tests {
+		result := x.left.Compare(x.right)
+		if result > 0 {
+			result = 1
+		} else if result < 0 {
+			result = -1
+		}
+		if result != x.output {
+			t.Errorf("Expected %v comparing %v to %v, got %v", x.output, x.left, x.right, result)
+		}
+	}
+
+}
+
+func TestViewRangeIsEmpty(t *testing.T) {
+
+	tests := []struct {
+		input  *ViewRange
+		output bool
+	}{
+		{&ViewRange{MIN_LOCATION, MAX_LOCATION}, false},
+		// x = 5
+		{&ViewRange{NewViewLocationGreatherThan(5.0, true), NewViewLocationLessThan(5.0, true)}, false},
+		// x >= 5 AND x < 5
+		{&ViewRange{NewViewLocationGreatherThan(5.0, true), NewViewLocationLessThan(5.0, false)}, true},
+		// x > 5 AND x <= 5
+		{&ViewRange{NewViewLocationGreatherThan(5.0, false), NewViewLocationLessThan(5.0, true)}, true},
+		// x > 5 AND x < 5
+		{&ViewRange{NewViewLocationGreatherThan(5.0, false), NewViewLocationLessThan(5.0, false)}, true},
+		// x > 7 AND x < 3
+		{&ViewRange{NewViewLocationGreatherThan(7.0, false), NewViewLocationLessThan(3.0, false)}, true},
+		// x IS NULL
+		{&ViewRange{NewViewLocationGreatherThan(nil, true), NewViewLocationLessThan(nil, true)}, false},
+	}
+
+	for _, x := range tests {
+		result := x.input.IsEmpty()
+		if result != x.output {
+			t.Errorf("Expected %v for %v, got %v", x.output, x.input.Printable(), result)
+		}
+	}
+
+}
+
+func TestViewScannerMergeRange(t *testing.T) {
+
+	tests := []struct {
+		input  []ast.BooleanExpression
+		output []*ViewRange
+	}{
+		{
+			[]ast.BooleanExpression{},
+			[]*ViewRange{&ViewRange{MIN_LOCATION, MAX_LOCATION}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0)),
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+			},
+			[]*ViewRange{&ViewRange{NewViewLocationGreatherThan(3.0, false), NewViewLocationLessThan(7.0, false)}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+				ast.NewLessThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0)),
+			},
+			[]*ViewRange{&ViewRange{MIN_LOCATION, NewViewLocationLessThan(3.0, false)}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0)),
+				ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+			},
+			[]*ViewRange{},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewGreaterThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+				ast.NewLessThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+			},
+			[]*ViewRange{&ViewRange{NewViewLocationGreatherThan(5.0, true), NewViewLocationLessThan(5.0, true)}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+				ast.NewLessThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+			},
+			[]*ViewRange{},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+				ast.NewNotEqualToOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+			},
+			[]*ViewRange{},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewNotEqualToOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+				ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0)),
+			},
+			[]*ViewRange{
+				&ViewRange{MIN_LOCATION, NewViewLocationLessThan(5.0, false)},
+				&ViewRange{NewViewLocationGreatherThan(5.0, false), NewViewLocationLessThan(7.0, false)},
+			},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewOrOperator([]ast.BooleanExpression{
+					ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+					ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0)),
+				}),
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+			},
+			[]*ViewRange{&ViewRange{NewViewLocationGreatherThan(7.0, false), MAX_LOCATION}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewOrOperator([]ast.BooleanExpression{
+					ast.NewLessThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+					ast.NewGreaterThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+				}),
+			},
+			[]*ViewRange{&ViewRange{MIN_LOCATION, MAX_LOCATION}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewInOperator(ast.NewProperty("x"), ast.NewLiteralArray([]ast.Expression{ast.NewLiteralNumber(7.0), ast.NewLiteralNumber(3.0)})),
+				ast.NewGreaterThanOrEqualOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+			},
+			[]*ViewRange{
+				&ViewRange{NewViewLocationGreatherThan(3.0, true), NewViewLocationLessThan(3.0, true)},
+				&ViewRange{NewViewLocationGreatherThan(7.0, true), NewViewLocationLessThan(7.0, true)},
+			},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewInOperator(ast.NewProperty("x"), ast.NewLiteralArray([]ast.Expression{})),
+			},
+			[]*ViewRange{},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewBetweenOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0), ast.NewLiteralNumber(7.0)),
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(5.0)),
+			},
+			[]*ViewRange{&ViewRange{NewViewLocationGreatherThan(5.0, false), NewViewLocationLessThan(7.0, true)}},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewBetweenOperator(ast.NewProperty("x"), ast.NewLiteralNumber(7.0), ast.NewLiteralNumber(3.0)),
+			},
+			[]*ViewRange{},
+		},
+		{
+			[]ast.BooleanExpression{
+				ast.NewIsNullOperator(ast.NewProperty("x")),
+				ast.NewGreaterThanOperator(ast.NewProperty("x"), ast.NewLiteralNumber(3.0)),
+			},
+			[]*ViewRange{},
+		},
+	}
+
+	for _, x := range tests {
+		scanner := &ViewScanner{
+			ranges: [][]*ViewRange{[]*ViewRange{&ViewRange{MIN_LOCATION, MAX_LOCATION}}},
+		}
+		for _, factor := range x.input {
+			newRanges, err := factorRanges(factor)
+			if err != nil {
+				t.Fatalf("Error computing ranges of %v: %v", factor, err)
+			}
+			scanner.MergeRange(0, newRanges)
+		}
+		ranges := scanner.Ranges()
+		if !rangesEqual(ranges, x.output) {
+			t.Errorf("Expected %v for %v, got %v", printableRanges(x.output), x.input, printableRanges(ranges))
+		}
+		if scanner.IsEmpty() != (len(x.output) == 0) {
+			t.Errorf("Expected empty %v for %v", len(x.output) == 0, x.input)
+		}
+	}
+
+}
+
+func TestViewLocationWithPrefix(t *testing.T) {
+
+	tests := []struct {
+		prefix   []interface{}
+		location *ViewLocation
+		moreKeys bool
+		output   *ViewLocation
+	}{
+		{[]interface{}{"ale"}, NewViewLocationGreatherThan(5.0, true), false, &ViewLocation{[]interface{}{"ale", 5.0}, &MIN_ID}},
+		{[]interface{}{"ale"}, NewViewLocationGreatherThan(5.0, true), true, &ViewLocation{[]interface{}{"ale", 5.0}, &MIN_ID}},
+		// after 5 is also after every key beginning with 5
+		{[]interface{}{"ale"}, NewViewLocationGreatherThan(5.0, false), true, &ViewLocation{[]interface{}{"ale", 5.0, MAX_KEY}, &MAX_ID}},
+		{[]interface{}{"ale"}, NewViewLocationLessThan(5.0, true), true, &ViewLocation{[]interface{}{"ale", 5.0, MAX_KEY}, &MAX_ID}},
+		{[]interface{}{"ale"}, NewViewLocationLessThan(5.0, true), false, &ViewLocation{[]interface{}{"ale", 5.0}, &MAX_ID}},
+		{[]interface{}{"ale"}, NewViewLocationLessThan(5.0, false), true, &ViewLocation{[]interface{}{"ale", 5.0}, &MIN_ID}},
+		{[]interface{}{}, MAX_LOCATION, true, &ViewLocation{[]interface{}{MAX_KEY, MAX_KEY}, &MAX_ID}},
+	}
+
+	for _, x := range tests {
+		result := NewViewLocationWithPrefix(x.prefix, x.location, x.moreKeys)
+		if result.Compare(x.output) != 0 || *result.Docid != *x.output.Docid {
+			t.Errorf("Expected %v %v for %v after %v, got %v %v", x.output.Key, *x.output.Docid, x.location.Key, x.prefix, result.Key, *result.Docid)
+		}
+	}
+
+}
+
+func TestViewScannerCompositeRanges(t *testing.T) {
+
+	typeProperty := ast.NewProperty("doc.type")
+	abvProperty := ast.NewProperty("doc.abv")
+	ale := ast.NewLiteralString("ale")
+	stout := ast.NewLiteralString("stout")
+
+	tests := []struct {
+		keys     []string
+		input    []ast.BooleanExpression
+		usedKeys int
+		matches  bool
+		output   []*ViewRange
+	}{
+		// an equality prefix followed by a range on the next key
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ale),
+				ast.NewGreaterThanOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+			},
+			2,
+			true,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{"ale", 5.0}, &MAX_ID}, &ViewLocation{[]interface{}{"ale", MAX_KEY}, &MAX_ID}},
+			},
+		},
+		// the equality alone still scans every value of the next key
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ale),
+			},
+			2,
+			true,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{"ale", MIN_KEY}, &MIN_ID}, &ViewLocation{[]interface{}{"ale", MAX_KEY}, &MAX_ID}},
+			},
+		},
+		// IN on the leading key gives a range for each value
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewInOperator(typeProperty, ast.NewLiteralArray([]ast.Expression{stout, ale})),
+				ast.NewLessThanOrEqualOperator(abvProperty, ast.NewLiteralNumber(6.0)),
+			},
+			2,
+			true,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{"ale", MIN_KEY}, &MIN_ID}, &ViewLocation{[]interface{}{"ale", 6.0}, &MAX_ID}},
+				&ViewRange{&ViewLocation{[]interface{}{"stout", MIN_KEY}, &MIN_ID}, &ViewLocation{[]interface{}{"stout", 6.0}, &MAX_ID}},
+			},
+		},
+		// partial prefixes are padded with MAX_KEY to cover the later keys
+		{
+			[]string{"doc.type", "doc.abv", "doc.name"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ale),
+				ast.NewGreaterThanOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+				ast.NewLessThanOrEqualOperator(abvProperty, ast.NewLiteralNumber(6.0)),
+			},
+			2,
+			true,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{"ale", 5.0, MAX_KEY}, &MAX_ID}, &ViewLocation{[]interface{}{"ale", 6.0, MAX_KEY}, &MAX_ID}},
+			},
+		},
+		{
+			[]string{"doc.type", "doc.abv", "doc.name"},
+			[]ast.BooleanExpression{
+				ast.NewLessThanOperator(typeProperty, ale),
+			},
+			1,
+			true,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{MIN_KEY}, &MIN_ID}, &ViewLocation{[]interface{}{"ale"}, &MIN_ID}},
+			},
+		},
+		// a later key alone cannot narrow the scan
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewGreaterThanOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+			},
+			1,
+			false,
+			[]*ViewRange{
+				&ViewRange{&ViewLocation{[]interface{}{MIN_KEY}, &MIN_ID}, &ViewLocation{[]interface{}{MAX_KEY, MAX_KEY}, &MAX_ID}},
+			},
+		},
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ale),
+				ast.NewEqualToOperator(typeProperty, stout),
+			},
+			2,
+			true,
+			[]*ViewRange{},
+		},
+	}
+
+	for _, x := range tests {
+		accessPath := datasource.NewCouchbaseViewAccessPath(nil, "ddoc", "view", x.keys)
+		scanner := NewViewScanner(accessPath)
+		for _, factor := range x.input {
+			if !scanner.AddBooleanFactor(factor) {
+				t.Fatalf("Expected %v to be supported by %v", factor, x.keys)
+			}
+		}
+		if !scanner.IsEmpty() && scanner.usedKeys() != x.usedKeys {
+			t.Errorf("Expected %d keys used for %v, got %d", x.usedKeys, x.input, scanner.usedKeys())
+		}
+		if accessPath.Matches(x.input) != x.matches {
+			t.Errorf("Expected match %v for %v with keys %v", x.matches, x.input, x.keys)
+		}
+		ranges := scanner.Ranges()
+		if !rangesEqual(ranges, x.output) {
+			t.Errorf("Expected %v for %v, got %v", printableRanges(x.output), x.input, printableRanges(ranges))
+		}
+	}
+
+}
+
+func TestViewScannerObjectKeys(t *testing.T) {
+
+	typeProperty := ast.NewProperty("doc.type")
+	abvProperty := ast.NewProperty("doc.abv")
+	docid := couchbase.DocId("beer")
+
+	tests := []struct {
+		keys     []string
+		input    []ast.BooleanExpression
+		key      interface{}
+		contains bool
+	}{
+		{
+			[]string{"doc.type"},
+			[]ast.BooleanExpression{},
+			map[string]interface{}{"a": 1.0},
+			true,
+		},
+		{
+			[]string{"doc.type", "doc.abv", "doc.brewery"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ast.NewLiteralString("ale")),
+				ast.NewLessThanOrEqualOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+			},
+			[]interface{}{"ale", 5.0, map[string]interface{}{"a": 1.0}},
+			true,
+		},
+		{
+			[]string{"doc.type", "doc.abv", "doc.brewery"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ast.NewLiteralString("ale")),
+				ast.NewLessThanOrEqualOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+			},
+			[]interface{}{"ale", 5.0, map[string]interface{}{"name": "x", "zip": map[string]interface{}{"a": 1.0}}},
+			true,
+		},
+		{
+			[]string{"doc.type", "doc.abv", "doc.brewery"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ast.NewLiteralString("ale")),
+				ast.NewLessThanOrEqualOperator(abvProperty, ast.NewLiteralNumber(5.0)),
+			},
+			[]interface{}{"ale", 6.0, map[string]interface{}{"a": 1.0}},
+			false,
+		},
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewEqualToOperator(typeProperty, ast.NewLiteralString("ale")),
+			},
+			[]interface{}{"ale", map[string]interface{}{"a": 1.0}},
+			true,
+		},
+		{
+			[]string{"doc.type", "doc.abv"},
+			[]ast.BooleanExpression{
+				ast.NewGreaterThanOperator(typeProperty, ast.NewLiteralString("ale")),
+			},
+			[]interface{}{map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": 1.0}},
+			true,
+		},
+	}
+
+	for _, x := range tests {
+		scanner := NewViewScanner(datasource.NewCouchbaseViewAccessPath(nil, "ddoc", "view", x.keys))
+		for _, factor := range x.input {
+			scanner.AddBooleanFactor(factor)
+		}
+		location := &ViewLocation{x.key, &docid}
+		contains := false
+		for _, r := range scanner.Ranges() {
+			if r.Contains(location) {
+				contains = true
+			}
+		}
+		if contains != x.contains {
+			t.Errorf("Expected %v in the ranges for %v to be %v", x.key, x.input, x.contains)
+		}
+	}
+
+}
+
+func rangesEqual(left, right []*ViewRange) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if left[i].Start.Compare(right[i].Start) != 0 || left[i].End.Compare(right[i].End) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func printableRanges(ranges []*ViewRange) []map[string]interface{} {
+	rv := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		rv = append(rv, r.Printable())
+	}
+	return rv
+}